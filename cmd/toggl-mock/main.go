@@ -0,0 +1,40 @@
+// Command toggl-mock serves the fake toggl API of package togglmock over the network,
+// for test suites not written in Go.
+//
+//	toggl-mock -addr :8080 -seed fixtures.json
+//
+// Point the client at http://localhost:8080 in place of the toggl hosts; paths are the same.
+// Seed files and the control API under /_togglmock are described in package togglmock.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+type seedFiles []string
+
+func (f *seedFiles) String() string     { return strings.Join(*f, ",") }
+func (f *seedFiles) Set(s string) error { *f = append(*f, s); return nil }
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	token := flag.String("token", "", "the only API token accepted, any token when empty")
+	var seeds seedFiles
+	flag.Var(&seeds, "seed", "seed file of routes and faults, may be repeated")
+	flag.Parse()
+
+	s := togglmock.New()
+	s.Token = *token
+	for _, path := range seeds {
+		if err := s.LoadSeedFile(path); err != nil {
+			log.Fatal(err)
+		}
+	}
+	log.Printf("toggl-mock listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, s.Handler()))
+}
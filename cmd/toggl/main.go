@@ -0,0 +1,164 @@
+// Command toggl tracks time from the terminal with the client library.
+//
+//	toggl start "Landing page" -p Website -t dev,review
+//	toggl stop
+//	toggl current
+//	toggl report -since 2016-06-01 -until 2016-06-30 -format table
+//
+// The API token and default workspace are read by package config, from
+// TOGGL_API_TOKEN and TOGGL_WORKSPACE_ID or ~/.togglrc.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/config"
+)
+
+const usage = `usage: toggl [-config file] [-base-url url] <command> [arguments]
+
+commands:
+  start <description> [-p project] [-t tags] [-b]
+                                               start a timer
+  stop                                         stop the running timer
+  current                                      show the running timer
+  report [-since date] [-until date] [-format csv|table|json]
+                                               show time entries of the detailed report
+`
+
+func main() {
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	configPath := flag.String("config", "", "configuration file, ~/.togglrc by default")
+	baseURL := flag.String("base-url", "", "send requests to another server, e.g. toggl-mock")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(context.Background(), *configPath, *baseURL, flag.Arg(0), flag.Args()[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "toggl:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, configPath, baseURL, command string, args []string) error {
+	commands := map[string]func(context.Context, *client.Client, []string) error{
+		"start":   start,
+		"stop":    stop,
+		"current": current,
+		"report":  report,
+	}
+	cmd, ok := commands[command]
+	if !ok {
+		flag.Usage()
+		return fmt.Errorf("unknown command %q", command)
+	}
+
+	conf, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+	var opts []client.Option
+	if baseURL != "" {
+		opts = append(opts, client.WithBaseURL(baseURL))
+	}
+	c, err := conf.NewClient(opts...)
+	if err != nil {
+		return err
+	}
+	if c.DefaultWorkspace() == 0 {
+		if _, err := c.Autodiscover(ctx); err != nil {
+			return err
+		}
+	}
+	return cmd(ctx, c, args)
+}
+
+// parse parses flags given before and after the positional arguments, as in `start "desc" -p project`.
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+func start(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("start", flag.ContinueOnError)
+	project := fs.String("p", "", "project name or ID")
+	tags := fs.String("t", "", "comma separated tags")
+	billable := fs.Bool("b", false, "billable")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	entry := &client.TimeEntry{
+		WorkspaceID: c.DefaultWorkspace(),
+		Description: strings.Join(positional, " "),
+		Billable:    *billable,
+	}
+	if *tags != "" {
+		entry.Tags = strings.Split(*tags, ",")
+	}
+	if *project != "" {
+		if entry.ProjectID, err = projectID(ctx, c, *project); err != nil {
+			return err
+		}
+	}
+	started, err := c.TimeEntries.Start(ctx, entry)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("started %q at %s\n", started.Description, started.Start.Local().Format("15:04"))
+	return nil
+}
+
+func projectID(ctx context.Context, c *client.Client, project string) (int, error) {
+	if id, err := strconv.Atoi(project); err == nil {
+		return id, nil
+	}
+	return client.NewResolver(c, 0, client.MatchFold).ProjectID(ctx, project)
+}
+
+func stop(ctx context.Context, c *client.Client, args []string) error {
+	stopped, err := c.TimeEntries.StopCurrent(ctx)
+	if errors.Is(err, client.ErrNoRunningEntry) {
+		fmt.Println("no timer is running")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("stopped %q after %s\n", stopped.Description, stopped.Duration)
+	return nil
+}
+
+func current(ctx context.Context, c *client.Client, args []string) error {
+	entry, err := c.TimeEntries.Current(ctx)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		fmt.Println("no timer is running")
+		return nil
+	}
+	fmt.Printf("%q running for %s since %s\n", entry.Description,
+		entry.Elapsed(time.Now()).Truncate(time.Second), entry.Start.Local().Format("15:04"))
+	return nil
+}
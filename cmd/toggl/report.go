@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/export"
+	"github.com/hitsumabushi/toggl-go/lib/schema"
+)
+
+const dateFormat = "2006-01-02"
+
+func report(ctx context.Context, c *client.Client, args []string) error {
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	today := time.Now().Format(dateFormat)
+	since := fs.String("since", time.Now().AddDate(0, 0, -6).Format(dateFormat), "first day of the report")
+	until := fs.String("until", today, "last day of the report")
+	format := fs.String("format", "table", "output format, csv, table or json")
+	if _, err := parse(fs, args); err != nil {
+		return err
+	}
+
+	params := &client.ReportParams{WorkspaceID: c.DefaultWorkspace()}
+	var err error
+	if params.Since, err = time.ParseInLocation(dateFormat, *since, time.Local); err != nil {
+		return fmt.Errorf("-since: %v", err)
+	}
+	if params.Until, err = time.ParseInLocation(dateFormat, *until, time.Local); err != nil {
+		return fmt.Errorf("-until: %v", err)
+	}
+
+	switch *format {
+	case "csv":
+		_, err = export.Export(ctx, c, params, export.FormatCSV, os.Stdout)
+		return err
+	case "json":
+		return reportJSON(ctx, c, params)
+	case "table":
+		return reportTable(ctx, c, params)
+	}
+	return fmt.Errorf("unknown format %q, expected csv, table or json", *format)
+}
+
+// eachReportRow calls fn with every row of the detailed report, with the names of
+// archived and deleted projects filled in.
+func eachReportRow(ctx context.Context, c *client.Client, params *client.ReportParams, fn func(*client.ReportTimeEntry) error) error {
+	names := client.NewNameBackfiller(c, params.WorkspaceID)
+	return c.Reports.EachDetailed(ctx, params, func(e *client.ReportTimeEntry) error {
+		rows := []client.ReportTimeEntry{*e}
+		if err := names.Fill(ctx, rows); err != nil {
+			return err
+		}
+		return fn(&rows[0])
+	})
+}
+
+func reportJSON(ctx context.Context, c *client.Client, params *client.ReportParams) error {
+	records := []schema.TimeEntry{}
+	err := eachReportRow(ctx, c, params, func(e *client.ReportTimeEntry) error {
+		rec := schema.FromReportTimeEntry(e)
+		rec.WorkspaceID = params.WorkspaceID
+		records = append(records, rec)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(records)
+}
+
+func reportTable(ctx context.Context, c *client.Client, params *client.ReportParams) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "DATE\tSTART\tDURATION\tPROJECT\tDESCRIPTION")
+	var total time.Duration
+	err := eachReportRow(ctx, c, params, func(e *client.ReportTimeEntry) error {
+		rec := schema.FromReportTimeEntry(e)
+		d := time.Duration(rec.DurationSeconds) * time.Second
+		total += d
+		start := rec.Start.Local()
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", start.Format(dateFormat), start.Format("15:04"), d, rec.Project, rec.Description)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "\t\t%s\tTOTAL\t\n", total)
+	return w.Flush()
+}
@@ -0,0 +1,182 @@
+// Package archive stores time entries for the long term in versioned JSON Lines files.
+// An archive is a header line followed by records of package schema.
+// Archives and records written by older versions of the library are migrated on read,
+// so multi-year archives stay readable when the models change.
+// JSON Lines exports of package export are read as archives of version 0.
+package archive
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/export"
+	"github.com/hitsumabushi/toggl-go/lib/schema"
+)
+
+// Version is the archive version written in the archive_version field of the header
+const Version = 1
+
+// KindArchive is the kind of the header line
+const KindArchive = "archive"
+
+// Header is the first line of an archive
+type Header struct {
+	Kind           string    `json:"kind"`
+	ArchiveVersion int       `json:"archive_version"`
+	CreatedAt      time.Time `json:"created_at"`
+	WorkspaceID    int       `json:"workspace_id,omitempty"`
+	Since          time.Time `json:"since,omitzero"`
+	Until          time.Time `json:"until,omitzero"`
+}
+
+// Migration upgrades a decoded document by one version in place
+type Migration func(doc map[string]interface{}) error
+
+// headerMigrations upgrade headers of archive version n to n+1.
+var headerMigrations = map[int]Migration{
+	// exports of package export have no header
+	0: func(doc map[string]interface{}) error {
+		doc["kind"] = KindArchive
+		return nil
+	},
+}
+
+// recordMigrations upgrade records of schema version n to n+1.
+// A change of package schema bumping schema.Version adds its migration here.
+var recordMigrations = map[int]Migration{}
+
+func migrate(doc map[string]interface{}, field string, from, to int, migrations map[int]Migration) error {
+	if from > to {
+		return fmt.Errorf("%s %d is newer than %d supported by this version", field, from, to)
+	}
+	for v := from; v < to; v++ {
+		m, ok := migrations[v]
+		if !ok {
+			return fmt.Errorf("no migration from %s %d", field, v)
+		}
+		if err := m(doc); err != nil {
+			return fmt.Errorf("migrating from %s %d: %v", field, v, err)
+		}
+		doc[field] = v + 1
+	}
+	return nil
+}
+
+// MigrateRecord upgrades a decoded record to schema.Version.
+func MigrateRecord(doc map[string]interface{}) error {
+	v, _ := doc["schema_version"].(float64)
+	if v < 1 {
+		return fmt.Errorf("record has no schema_version")
+	}
+	return migrate(doc, "schema_version", int(v), schema.Version, recordMigrations)
+}
+
+// MigrateHeader upgrades a decoded header to Version.
+func MigrateHeader(doc map[string]interface{}) error {
+	v, _ := doc["archive_version"].(float64)
+	return migrate(doc, "archive_version", int(v), Version, headerMigrations)
+}
+
+func convert(doc map[string]interface{}, v interface{}) error {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// Writer writes an archive
+type Writer struct {
+	enc *json.Encoder
+}
+
+// NewWriter writes the header to w and returns the Writer of the records.
+// Kind, ArchiveVersion and a zero CreatedAt of the header are set.
+func NewWriter(w io.Writer, header Header) (*Writer, error) {
+	header.Kind = KindArchive
+	header.ArchiveVersion = Version
+	if header.CreatedAt.IsZero() {
+		header.CreatedAt = time.Now()
+	}
+	enc := json.NewEncoder(w)
+	if err := enc.Encode(&header); err != nil {
+		return nil, err
+	}
+	return &Writer{enc: enc}, nil
+}
+
+// Write writes a record.
+func (w *Writer) Write(rec *schema.TimeEntry) error {
+	return w.enc.Encode(rec)
+}
+
+// Archive writes the time entries of the detailed report to w as an archive, fetching it page by page.
+// It returns the number of entries written.
+func Archive(ctx context.Context, c *client.Client, params *client.ReportParams, w io.Writer) (int, error) {
+	workspaceID := params.WorkspaceID
+	if workspaceID == 0 {
+		workspaceID = c.DefaultWorkspace()
+	}
+	_, err := NewWriter(w, Header{WorkspaceID: workspaceID, Since: params.Since, Until: params.Until})
+	if err != nil {
+		return 0, err
+	}
+	return export.Export(ctx, c, params, export.FormatJSONL, w)
+}
+
+// Read calls fn with every time entry of the archive read from r, migrated to schema.Version,
+// and returns the header migrated to Version.
+// Records of other kinds are skipped. It stops at the first error of fn, which is returned as is.
+func Read(r io.Reader, fn func(*schema.TimeEntry) error) (*Header, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	var header *Header
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		doc := map[string]interface{}{}
+		if err := json.Unmarshal(scanner.Bytes(), &doc); err != nil {
+			return header, fmt.Errorf("line %d: %v", line, err)
+		}
+		if header == nil {
+			header = &Header{}
+			hdoc := doc
+			if doc["kind"] != KindArchive {
+				hdoc = map[string]interface{}{}
+			}
+			if err := MigrateHeader(hdoc); err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			if err := convert(hdoc, header); err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			if doc["kind"] == KindArchive {
+				continue
+			}
+		}
+		if doc["kind"] != schema.KindTimeEntry {
+			continue
+		}
+		if err := MigrateRecord(doc); err != nil {
+			return header, fmt.Errorf("line %d: %v", line, err)
+		}
+		rec := &schema.TimeEntry{}
+		if err := convert(doc, rec); err != nil {
+			return header, fmt.Errorf("line %d: %v", line, err)
+		}
+		if err := fn(rec); err != nil {
+			return header, err
+		}
+	}
+	if header == nil {
+		header = &Header{Kind: KindArchive, ArchiveVersion: Version}
+	}
+	return header, scanner.Err()
+}
@@ -0,0 +1,132 @@
+package archive
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hitsumabushi/toggl-go/lib/schema"
+)
+
+func readAll(t *testing.T, in string) (*Header, []*schema.TimeEntry, error) {
+	t.Helper()
+	var recs []*schema.TimeEntry
+	h, err := Read(strings.NewReader(in), func(rec *schema.TimeEntry) error {
+		recs = append(recs, rec)
+		return nil
+	})
+	return h, recs, err
+}
+
+func TestWriteRead(t *testing.T) {
+	since := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, Header{WorkspaceID: 1, Since: since})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := &schema.TimeEntry{SchemaVersion: schema.Version, Kind: schema.KindTimeEntry, ID: 4999, Description: "review", Start: since}
+	if err := w.Write(rec); err != nil {
+		t.Fatal(err)
+	}
+	buf.WriteString("\n" + `{"schema_version":1,"kind":"totals"}` + "\n")
+
+	h, recs, err := readAll(t, buf.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Kind != KindArchive || h.ArchiveVersion != Version || h.WorkspaceID != 1 || !h.Since.Equal(since) || h.CreatedAt.IsZero() {
+		t.Errorf("header = %+v", h)
+	}
+	if len(recs) != 1 || recs[0].ID != 4999 || recs[0].Description != "review" {
+		t.Errorf("records = %+v, want entry 4999 only", recs)
+	}
+}
+
+func TestReadExport(t *testing.T) {
+	h, recs, err := readAll(t, `{"schema_version":1,"kind":"time_entry","id":4998}`+"\n"+`{"schema_version":1,"kind":"time_entry","id":4999}`+"\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Kind != KindArchive || h.ArchiveVersion != Version {
+		t.Errorf("header = %+v, want an upgraded version 0 header", h)
+	}
+	if len(recs) != 2 || recs[0].ID != 4998 || recs[1].ID != 4999 {
+		t.Errorf("records = %+v, want entries 4998 and 4999", recs)
+	}
+}
+
+func TestReadEmpty(t *testing.T) {
+	h, recs, err := readAll(t, "")
+	if err != nil || h.Kind != KindArchive || h.ArchiveVersion != Version || len(recs) != 0 {
+		t.Errorf("Read() = %+v, %v, %v", h, recs, err)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	header := `{"kind":"archive","archive_version":1}` + "\n"
+	for name, in := range map[string]string{
+		"newer archive":     `{"kind":"archive","archive_version":2}`,
+		"newer record":      header + `{"schema_version":2,"kind":"time_entry"}`,
+		"no schema_version": header + `{"kind":"time_entry"}`,
+		"invalid json":      header + `{"kind":`,
+	} {
+		if _, _, err := readAll(t, in); err == nil {
+			t.Errorf("%s: Read() returned no error", name)
+		}
+	}
+}
+
+func TestReadStopsAtFnError(t *testing.T) {
+	errStop := errors.New("stop")
+	n := 0
+	_, err := Read(strings.NewReader(`{"schema_version":1,"kind":"time_entry","id":1}`+"\n"+`{"schema_version":1,"kind":"time_entry","id":2}`), func(*schema.TimeEntry) error {
+		n++
+		return errStop
+	})
+	if err != errStop || n != 1 {
+		t.Errorf("Read() = %v after %d calls, want errStop after 1", err, n)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	migrations := map[int]Migration{
+		1: func(doc map[string]interface{}) error {
+			doc["name"] = doc["description"]
+			delete(doc, "description")
+			return nil
+		},
+		2: func(doc map[string]interface{}) error {
+			doc["name"] = strings.ToUpper(doc["name"].(string))
+			return nil
+		},
+	}
+	doc := map[string]interface{}{"v": 1, "description": "review"}
+	if err := migrate(doc, "v", 1, 3, migrations); err != nil {
+		t.Fatal(err)
+	}
+	if doc["name"] != "REVIEW" || doc["v"] != 3 {
+		t.Errorf("migrated = %v, want name REVIEW at version 3", doc)
+	}
+
+	if err := migrate(map[string]interface{}{"description": "review"}, "v", 1, 4, migrations); err == nil || !strings.Contains(err.Error(), "no migration from v 3") {
+		t.Errorf("migrate() without a migration = %v", err)
+	}
+	failing := map[int]Migration{1: func(map[string]interface{}) error { return errors.New("bad") }}
+	if err := migrate(map[string]interface{}{}, "v", 1, 2, failing); err == nil || !strings.Contains(err.Error(), "bad") {
+		t.Errorf("migrate() with a failing migration = %v", err)
+	}
+}
+
+func TestMigrateRecord(t *testing.T) {
+	// records always had a schema_version, so version 0 is not upgraded
+	if err := MigrateRecord(map[string]interface{}{"schema_version": 0.0}); err == nil {
+		t.Error("MigrateRecord() accepted schema_version 0")
+	}
+	doc := map[string]interface{}{"schema_version": float64(schema.Version)}
+	if err := MigrateRecord(doc); err != nil || doc["schema_version"] != float64(schema.Version) {
+		t.Errorf("MigrateRecord() of the current version = %v, %v", doc, err)
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// NameBackfiller fills project and client names of report rows which only have IDs,
+// as the reports API leaves them blank for archived and deleted objects.
+// Names are read from the project and client lists, then archived projects,
+// and projects which can not be found any more are named after their ID.
+type NameBackfiller struct {
+	client      *Client
+	workspaceID int
+
+	projects map[int]Project
+	clients  map[int]string
+	archived bool
+}
+
+// NewNameBackfiller returns a NameBackfiller of the workspace.
+func NewNameBackfiller(c *Client, workspaceID int) *NameBackfiller {
+	return &NameBackfiller{client: c, workspaceID: workspaceID}
+}
+
+func (b *NameBackfiller) load(ctx context.Context) error {
+	if b.projects != nil {
+		return nil
+	}
+	projects, err := b.client.Projects.List(ctx, b.workspaceID)
+	if err != nil {
+		return err
+	}
+	clients, err := b.client.Clients.List(ctx, b.workspaceID)
+	if err != nil {
+		return err
+	}
+	b.projects = make(map[int]Project, len(projects))
+	for _, p := range projects {
+		b.projects[p.ID] = p
+	}
+	b.clients = make(map[int]string, len(clients))
+	for _, cl := range clients {
+		b.clients[cl.ID] = cl.Name
+	}
+	return nil
+}
+
+func (b *NameBackfiller) project(ctx context.Context, id int) (Project, error) {
+	if err := b.load(ctx); err != nil {
+		return Project{}, err
+	}
+	if p, ok := b.projects[id]; ok {
+		return p, nil
+	}
+	if !b.archived {
+		b.archived = true
+		projects, err := b.client.Projects.ListAll(ctx, b.workspaceID)
+		if err != nil {
+			return Project{}, err
+		}
+		for _, p := range projects {
+			b.projects[p.ID] = p
+		}
+		if p, ok := b.projects[id]; ok {
+			return p, nil
+		}
+	}
+	p := Project{ID: id, Name: fmt.Sprintf("Deleted project %d", id)}
+	b.projects[id] = p
+	return p, nil
+}
+
+// Fill sets the blank Project and Client of rows which have a project ID.
+func (b *NameBackfiller) Fill(ctx context.Context, rows []ReportTimeEntry) error {
+	for i := range rows {
+		row := &rows[i]
+		if row.ProjectID == 0 || row.Project != "" && row.Client != "" {
+			continue
+		}
+		p, err := b.project(ctx, row.ProjectID)
+		if err != nil {
+			return err
+		}
+		if row.Project == "" {
+			row.Project = p.Name
+		}
+		if row.Client == "" && p.ClientID != 0 {
+			row.Client = b.clients[p.ClientID]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestNameBackfillerFill(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := []client.ReportTimeEntry{
+		{ProjectID: 100, Project: "Website", Client: "Acme"},
+		{ProjectID: 101},
+		{ProjectID: 999},
+		{Description: "no project"},
+	}
+	if err := client.NewNameBackfiller(c, 1).Fill(context.Background(), rows); err != nil {
+		t.Fatal(err)
+	}
+	want := [][2]string{{"Website", "Acme"}, {"Support", "Globex"}, {"Deleted project 999", ""}, {"", ""}}
+	for i, w := range want {
+		if rows[i].Project != w[0] || rows[i].Client != w[1] {
+			t.Errorf("row %d = %q, %q, want %q, %q", i, rows[i].Project, rows[i].Client, w[0], w[1])
+		}
+	}
+}
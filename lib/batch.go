@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultBatchWorkers is the number of requests Batch runs at once when given 0
+const DefaultBatchWorkers = 4
+
+// BatchError is returned by Batch when some of the requests failed, keyed like the results
+type BatchError[K comparable] struct {
+	Errors map[K]error
+	// Total is the number of requests of the batch
+	Total int
+
+	// keys are the keys of the batch in order, to report failures in a stable order
+	keys []K
+}
+
+// Error names the first failed key in the order of the batch.
+func (e *BatchError[K]) Error() string {
+	for _, key := range e.keys {
+		if err, ok := e.Errors[key]; ok {
+			return fmt.Sprintf("%d of %d requests failed, %v: %v", len(e.Errors), e.Total, key, err)
+		}
+	}
+	return fmt.Sprintf("%d of %d requests failed", len(e.Errors), e.Total)
+}
+
+// Unwrap returns the errors of the failed requests, in the order of the batch.
+func (e *BatchError[K]) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, key := range e.keys {
+		if err, ok := e.Errors[key]; ok {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Batch calls fn for every key on at most workers goroutines, DefaultBatchWorkers when 0,
+// and returns the results of the calls which succeeded by key.
+// When some fail, it returns the other results along with a *BatchError of the failures.
+// Requests of fn made with the client share its rate limit, see WithRateLimit, so workers
+// bound the requests in flight while the limiter spaces them and backs off on 429 for all.
+// Keys not started when ctx is done fail with the error of ctx. fn is called once per distinct key.
+func Batch[K comparable, T any](ctx context.Context, workers int, keys []K, fn func(context.Context, K) (T, error)) (map[K]T, error) {
+	keys = distinct(keys)
+	if workers <= 0 {
+		workers = DefaultBatchWorkers
+	}
+	if workers > len(keys) {
+		workers = len(keys)
+	}
+
+	var mu sync.Mutex
+	results := make(map[K]T, len(keys))
+	failures := map[K]error{}
+	queue := make(chan K)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range queue {
+				var v T
+				err := ctx.Err()
+				if err == nil {
+					v, err = fn(ctx, key)
+				}
+				mu.Lock()
+				if err != nil {
+					failures[key] = err
+				} else {
+					results[key] = v
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, key := range keys {
+		queue <- key
+	}
+	close(queue)
+	wg.Wait()
+
+	if len(failures) > 0 {
+		return results, &BatchError[K]{Errors: failures, Total: len(keys), keys: keys}
+	}
+	return results, nil
+}
+
+// distinct returns the keys without repetitions, in order of first appearance.
+func distinct[K comparable](keys []K) []K {
+	seen := make(map[K]bool, len(keys))
+	unique := make([]K, 0, len(keys))
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, key)
+		}
+	}
+	return unique
+}
+
+// BatchGet fetches resources, keyed by the names the results are returned by, like Do with GET.
+// See Batch for workers and failures.
+func BatchGet[T any](ctx context.Context, c *Client, workers int, resources map[string]string) (map[string]T, error) {
+	keys := make([]string, 0, len(resources))
+	for key := range resources {
+		keys = append(keys, key)
+	}
+	return Batch(ctx, workers, keys, func(ctx context.Context, key string) (T, error) {
+		return Do[T](ctx, c, "GET", resources[key], nil, nil)
+	})
+}
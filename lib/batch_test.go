@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBatchError(t *testing.T) {
+	var calls atomic.Int32
+	keys := []int{5, 3, 5, 1, 4, 3, 2}
+	results, err := Batch(context.Background(), 3, keys, func(ctx context.Context, key int) (int, error) {
+		calls.Add(1)
+		if key%2 == 1 {
+			return 0, fmt.Errorf("odd key %d", key)
+		}
+		return key * 10, nil
+	})
+	if got := calls.Load(); got != 5 {
+		t.Errorf("fn called %d times, want once per distinct key", got)
+	}
+	if len(results) != 2 || results[2] != 20 || results[4] != 40 {
+		t.Errorf("results = %v, want 2 and 4", results)
+	}
+
+	var berr *BatchError[int]
+	if !errors.As(err, &berr) {
+		t.Fatalf("error = %v, want a *BatchError", err)
+	}
+	for i := 0; i < 10; i++ {
+		if got, want := berr.Error(), "3 of 5 requests failed, 5: odd key 5"; got != want {
+			t.Fatalf("Error() = %q, want %q", got, want)
+		}
+	}
+	unwrapped := berr.Unwrap()
+	if len(unwrapped) != 3 || unwrapped[0].Error() != "odd key 5" || unwrapped[1].Error() != "odd key 3" || unwrapped[2].Error() != "odd key 1" {
+		t.Errorf("Unwrap() = %v, want the errors of 5, 3 and 1", unwrapped)
+	}
+}
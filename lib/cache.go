@@ -0,0 +1,247 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores response bodies of idempotent GET requests.
+// Keys start with an identifier of the API token the response was answered for, followed by the
+// request URL, so a cache can be shared by clients of different users and keys of a CredentialProvider.
+type Cache interface {
+	// Get returns the value of key unless it is missing or expired
+	Get(key string) ([]byte, bool)
+	// Set stores value for ttl
+	Set(key string, value []byte, ttl time.Duration)
+	// Delete removes the values whose key starts with prefix
+	Delete(prefix string)
+}
+
+type memoryCacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// MemoryCache is an in-memory Cache
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+// NewMemoryCache returns an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: map[string]memoryCacheEntry{}}
+}
+
+// Get implements Cache.
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// Set implements Cache.
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = memoryCacheEntry{value: value, expires: time.Now().Add(ttl)}
+}
+
+// Delete implements Cache.
+func (m *MemoryCache) Delete(prefix string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key := range m.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.entries, key)
+		}
+	}
+}
+
+// WithCache caches responses of workspaces, projects, clients and tags lookups.
+// Responses are kept as long as their Cache-Control or Expires headers allow,
+// or for ttl when the API sends neither. Responses marked no-store or no-cache are not kept.
+func WithCache(cache Cache, ttl time.Duration) Option {
+	return func(c *Client) error {
+		c.cache = cache
+		c.cacheTTL = ttl
+		return nil
+	}
+}
+
+// cachePrefix returns the start of the cache keys of the token, and remembers it to invalidate
+// the responses of every key the client used.
+func (c *Client) cachePrefix(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	prefix := hex.EncodeToString(sum[:8]) + " "
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	if c.cachePrefixes == nil {
+		c.cachePrefixes = map[string]bool{}
+	}
+	c.cachePrefixes[prefix] = true
+	return prefix
+}
+
+// cacheDelete drops the cached responses whose URL starts with prefix, for every key of the client.
+func (c *Client) cacheDelete(prefix string) {
+	if c.cache == nil {
+		return
+	}
+	if c.apiKey != nil {
+		c.cachePrefix(c.apiKey.Token)
+	}
+	c.cacheMu.Lock()
+	prefixes := make([]string, 0, len(c.cachePrefixes))
+	for p := range c.cachePrefixes {
+		prefixes = append(prefixes, p)
+	}
+	c.cacheMu.Unlock()
+	for _, p := range prefixes {
+		c.cache.Delete(p + prefix)
+	}
+}
+
+// cacheLifetime returns how long the response may be kept and where it was taken from.
+// ok is false when the response must not be stored.
+func cacheLifetime(header http.Header, fallback time.Duration) (ttl time.Duration, reason string, ok bool) {
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		switch {
+		case directive == "no-store" || directive == "no-cache":
+			return 0, directive, false
+		case strings.HasPrefix(directive, "max-age="):
+			seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+			if err != nil {
+				continue
+			}
+			if seconds <= 0 {
+				return 0, "max-age", false
+			}
+			return time.Duration(seconds) * time.Second, "max-age", true
+		}
+	}
+
+	if expires := header.Get("Expires"); expires != "" {
+		at, err := http.ParseTime(expires)
+		if err != nil {
+			return 0, "expires", false
+		}
+		now := time.Now()
+		if date, err := http.ParseTime(header.Get("Date")); err == nil {
+			now = date
+		}
+		if !at.After(now) {
+			return 0, "expires", false
+		}
+		return at.Sub(now), "expires", true
+	}
+
+	if fallback <= 0 {
+		return 0, "no ttl", false
+	}
+	return fallback, "default ttl", true
+}
+
+// getCached is get served from the cache when the client has one.
+func (c *Client) getCached(ctx context.Context, rawurl string, body interface{}) error {
+	if c.cache == nil {
+		return c.get(ctx, rawurl, body)
+	}
+
+	// The response is looked up for the key the request is sent with,
+	// and stored for the key it was answered for, another one after a 401 or 429
+	credential, err := c.credential(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = WithCallOptions(ctx, func(s *callSettings) { s.credential = credential })
+	suffix := rawurl
+	if base := callSettingsFrom(ctx).baseURL; base != nil {
+		suffix += " " + base.String()
+	}
+	token := ""
+	if credential != nil {
+		token = credential.Token
+	}
+	key := c.cachePrefix(token) + suffix
+	if cached, ok := c.cache.Get(key); ok {
+		c.emit(&CacheEvent{Decision: CacheHit, URL: rawurl})
+		return c.codec.Unmarshal(cached, body)
+	}
+	c.emit(&CacheEvent{Decision: CacheMiss, URL: rawurl})
+
+	// Expired responses with validators are revalidated, unless the call is conditional itself
+	var opts []requestOption
+	var stale *revalidation
+	if callSettingsFrom(ctx).validators == nil {
+		if r, found := c.loadRevalidation(key); found {
+			stale = r
+			opts = append(opts, withValidators(&r.Validators))
+		}
+	}
+	req, err := c.newRequest(ctx, "GET", rawurl, nil, opts...)
+	if err != nil {
+		return err
+	}
+	var raw json.RawMessage
+	resp, err := c.send(req, &raw)
+	revalidated := err == ErrNotModified && stale != nil
+	if revalidated {
+		raw = stale.Body
+	} else if err != nil {
+		return err
+	}
+
+	if answered, _, ok := resp.Request.BasicAuth(); ok && answered != token {
+		key = c.cachePrefix(answered) + suffix
+	}
+	ttl, reason, ok := cacheLifetime(resp.Header, c.cacheTTL)
+	if ok {
+		c.cache.Set(key, raw, ttl)
+	}
+	if reason != "no-store" {
+		c.storeRevalidation(key, resp.Header, raw)
+	}
+	switch {
+	case revalidated:
+		c.emit(&CacheEvent{Decision: CacheRevalidate, URL: rawurl, TTL: ttl, Reason: reason})
+	case ok:
+		c.emit(&CacheEvent{Decision: CacheStore, URL: rawurl, TTL: ttl, Reason: reason})
+	default:
+		c.emit(&CacheEvent{Decision: CacheSkip, URL: rawurl, Reason: reason})
+	}
+	return c.codec.Unmarshal(raw, body)
+}
+
+// InvalidateCache drops every cached response of the client.
+func (c *Client) InvalidateCache() {
+	c.cacheDelete("")
+}
+
+// invalidateCachedWorkspace drops cached responses under the workspace URL.
+// Workspaces whose ID starts with the same digits are dropped too, which only costs a request.
+func (c *Client) invalidateCachedWorkspace(workspaceID int) {
+	c.cacheDelete(fmt.Sprintf("%s/%d", endpointWorkspaces, workspaceID))
+}
+
+// invalidateCachedWorkspaces drops the cached list of workspaces, with every cached workspace under it.
+func (c *Client) invalidateCachedWorkspaces() {
+	c.cacheDelete(endpointWorkspaces)
+}
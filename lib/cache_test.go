@@ -0,0 +1,48 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestCachePerCredential(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(
+		client.WithDefaultWorkspace(1),
+		client.WithCache(client.NewMemoryCache(), time.Hour),
+		client.WithAPIKeys(&client.APIKey{Token: "first", Secret: "api_token"}, &client.APIKey{Token: "second", Secret: "api_token"}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	list := func() int {
+		if _, err := c.Workspaces.List(ctx); err != nil {
+			t.Fatal(err)
+		}
+		return len(server.RequestsTo("GET", "/api/v8/workspaces"))
+	}
+
+	// Keys take turns: each of them fetches the list once, then it is cached for both
+	if got := []int{list(), list(), list(), list()}; got[1] != 2 || got[3] != 2 {
+		t.Errorf("requests after each list = %v, want two requests, one per key", got)
+	}
+
+	if _, err := c.Workspaces.Update(ctx, 0, &client.WorkspaceParams{Name: "Renamed"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := list(); got != 3 {
+		t.Errorf("requests after updating a workspace = %d, want the list fetched again", got)
+	}
+	if _, err := c.Organizations.CreateWorkspace(ctx, 500, &client.WorkspaceParams{Name: "New"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := list(); got != 4 {
+		t.Errorf("requests after creating a workspace = %d, want the list fetched again", got)
+	}
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// CallOption changes a single call. Call options travel in the context of the call,
+// see WithCallOptions, so every service method accepts them without extra arguments.
+type CallOption func(*callSettings)
+
+type callSettings struct {
+	baseURL    *url.URL
+	capture    **http.Response
+	validators *Validators
+	// credential pins the API key of the call, e.g. the key a cached response is looked up for
+	credential *APIKey
+	timeout    time.Duration
+	deadline   time.Time
+	err        error
+}
+
+type callSettingsKey struct{}
+
+// WithCallOptions returns a copy of ctx carrying the call options, on top of the ones already in ctx.
+func WithCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	settings := callSettings{}
+	if parent, ok := ctx.Value(callSettingsKey{}).(*callSettings); ok {
+		settings = *parent
+	}
+	for _, opt := range opts {
+		opt(&settings)
+	}
+	return context.WithValue(ctx, callSettingsKey{}, &settings)
+}
+
+// noCallSettings are the settings of calls without call options. It must not be modified.
+var noCallSettings = &callSettings{}
+
+func callSettingsFrom(ctx context.Context) *callSettings {
+	if settings, ok := ctx.Value(callSettingsKey{}).(*callSettings); ok {
+		return settings
+	}
+	return noCallSettings
+}
+
+// CallBaseURL sends the call to the given scheme and host, instead of the ones of the client.
+// A path in baseURL is prefixed to the API path like WithBaseURL does.
+func CallBaseURL(baseURL string) CallOption {
+	return func(s *callSettings) {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			s.err = err
+			return
+		}
+		s.baseURL = u
+	}
+}
+
+// CaptureResponse stores the raw response of the call in *resp, for debugging unexpected payloads.
+// Its body holds the whole payload, even though the client already decoded it, and can be read
+// again after Close. Calls sending several requests, e.g. paginated ones, store the last response,
+// and calls served by the cache of WithCache leave *resp untouched.
+func CaptureResponse(resp **http.Response) CallOption {
+	return func(s *callSettings) {
+		s.capture = resp
+	}
+}
+
+// replayBody is a response body which rewinds on Close.
+type replayBody struct {
+	*bytes.Reader
+}
+
+func (b replayBody) Close() error {
+	_, err := b.Seek(0, io.SeekStart)
+	return err
+}
+
+// capture buffers the body of resp and stores a copy of resp when the call asks for it.
+func capture(resp *http.Response) error {
+	target := callSettingsFrom(resp.Request.Context()).capture
+	if target == nil {
+		return nil
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	captured := *resp
+	captured.Body = replayBody{bytes.NewReader(b)}
+	*target = &captured
+	return nil
+}
@@ -2,24 +2,41 @@ package client
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 const (
 	endpointWorkspaces     = "https://www.toggl.com/api/v8/workspaces"
 	endpointClients        = "https://www.toggl.com/api/v8/clients"
+	endpointProjects       = "https://www.toggl.com/api/v8/projects"
+	endpointTags           = "https://www.toggl.com/api/v8/tags"
+	endpointTasks          = "https://www.toggl.com/api/v8/tasks"
+	endpointProjectUsers   = "https://www.toggl.com/api/v8/project_users"
+	endpointGroups         = "https://www.toggl.com/api/v8/groups"
+	endpointWorkspaceUsers = "https://www.toggl.com/api/v8/workspace_users"
 	endpointReportWeekly   = "https://toggl.com/reports/api/v2/weekly"
 	endpointReportDetailed = "https://toggl.com/reports/api/v2/details"
 	endpointReportSummary  = "https://toggl.com/reports/api/v2/summary"
+	endpointReportsV3      = "https://api.track.toggl.com/reports/api/v3/workspace"
+	endpointReportsShared  = "https://api.track.toggl.com/reports/api/v3/shared"
+	endpointV9             = "https://api.track.toggl.com/api/v9"
 	endpointStartTime      = "https://www.toggl.com/api/v8/time_entries/start"
+	endpointTimeEntries    = "https://www.toggl.com/api/v8/time_entries"
+	endpointMe             = "https://www.toggl.com/api/v8/me"
+	endpointWebhooks       = "https://track.toggl.com/webhooks/api/v1/subscriptions"
 
 	// APISecret is specified from toggl
 	apiSecret       = "api_token"
 	contentTypeJSON = "application/json"
+	contentTypeForm = "application/x-www-form-urlencoded"
 	userAgent       = "toggl-go/0.1"
 )
 
@@ -59,79 +76,266 @@ type Endpoint interface {
 
 // Client store basic information for use toggl API
 type Client struct {
-	resources   *Resources
-	apiKey      *APIKey
-	contentType string
-	userAgent   string
+	resources      *Resources
+	apiKey         *APIKey
+	credentials    CredentialProvider
+	auth           atomic.Value
+	limiter        *rateLimiter
+	taxonomies     map[int]*Taxonomy
+	timeout        time.Duration
+	reportTimeout  time.Duration
+	contentType    string
+	userAgent      string
+	acceptLanguage string
+	lookups        *lookupCache
+	httpClient     *http.Client
+	baseURL        *url.URL
+	cache          Cache
+	cacheTTL       time.Duration
+	cacheMu        sync.Mutex
+	cachePrefixes  map[string]bool
+	onEvent        func(Event)
+	reconcile      bool
+	restoreStopped func(*ImplicitStopEvent) bool
+	codec          Codec
+
+	defaultWorkspace atomic.Int64
+
+	validateCredentials bool
+
+	Projects      *ProjectsService
+	Clients       *ClientsService
+	Webhooks      *WebhooksService
+	Workspaces    *WorkspacesService
+	Reports       *ReportsService
+	ReportsV3     *ReportsV3Service
+	SavedReports  *SavedReportsService
+	Tags          *TagsService
+	TimeEntries   *TimeEntriesService
+	Organizations *OrganizationsService
+	Groups        *GroupsService
 }
 
 // NewClient return a Client instance if not return error
-func NewClient(apiKey *APIKey, resources *Resources) (*Client, error) {
-	return &Client{
+func NewClient(apiKey *APIKey, resources *Resources, opts ...Option) (*Client, error) {
+	c := &Client{
 		resources:   resources,
 		apiKey:      apiKey,
 		contentType: contentTypeJSON,
 		userAgent:   userAgent,
-	}, nil
+		lookups:     newLookupCache(),
+		httpClient:  http.DefaultClient,
+		codec:       JSONCodec{},
+	}
+	c.Projects = &ProjectsService{client: c}
+	c.Clients = &ClientsService{client: c}
+	c.Webhooks = &WebhooksService{client: c}
+	c.Workspaces = &WorkspacesService{client: c}
+	c.Reports = &ReportsService{client: c}
+	c.ReportsV3 = &ReportsV3Service{client: c}
+	c.SavedReports = &SavedReportsService{client: c}
+	c.Tags = &TagsService{client: c}
+	c.TimeEntries = &TimeEntriesService{client: c}
+	c.Organizations = &OrganizationsService{client: c}
+	c.Groups = &GroupsService{client: c}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.validateCredentials {
+		if err := c.checkCredentials(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// checkCredentials requests the current user to confirm the API token is accepted.
+func (c *Client) checkCredentials(ctx context.Context) error {
+	err := c.get(ctx, endpointMe, nil)
+	if e, ok := err.(ErrorResponse); ok && (e.Code == http.StatusUnauthorized || e.Code == http.StatusForbidden) {
+		return ErrUnauthorized
+	}
+	return err
 }
 
 func (c *Client) buildURL(resource string) (*url.URL, error) {
 	return c.resources.GetURL(resource)
 }
 
-func (c *Client) buildRequest(method, path string, body io.Reader) (req *http.Request, err error) {
+// requestOption changes headers of a request built by buildRequest or newRequest
+type requestOption func(*http.Request)
+
+// withContentType sets the Content-Type of the request body.
+func withContentType(contentType string) requestOption {
+	return func(req *http.Request) {
+		req.Header.Set("Content-Type", contentType)
+	}
+}
+
+// withAccept sets the media type the response is expected in, e.g. text/csv for report downloads.
+func withAccept(accept string) requestOption {
+	return func(req *http.Request) {
+		req.Header.Set("Accept", accept)
+	}
+}
+
+func (c *Client) buildRequest(method, path string, body io.Reader, opts ...requestOption) (req *http.Request, err error) {
 	endpoint, err := c.buildURL(path)
 	if err != nil {
 		return
 	}
-	req, err = http.NewRequest(method, endpoint.String(), body)
+	return c.newRequest(context.Background(), method, endpoint.String(), body, opts...)
+}
+
+func (c *Client) newRequest(ctx context.Context, method, rawurl string, body io.Reader, opts ...requestOption) (req *http.Request, err error) {
+	req, err = http.NewRequest(method, rawurl, body)
 	if err != nil {
 		return
 	}
+	req = req.WithContext(ctx)
+	settings := callSettingsFrom(ctx)
+	if settings.err != nil {
+		return nil, settings.err
+	}
+	if settings.baseURL != nil {
+		rebase(req.URL, settings.baseURL)
+	} else if c.baseURL != nil {
+		rebase(req.URL, c.baseURL)
+	}
 
-	req.SetBasicAuth(c.apiKey.Token, c.apiKey.Secret)
+	key := settings.credential
+	if key == nil {
+		if key, err = c.credential(ctx); err != nil {
+			return nil, err
+		}
+	}
+	c.setAuth(req, key)
 	req.Header.Add("User-Agent", c.userAgent)
 	req.Header.Add("Content-Type", c.contentType)
+	req.Header.Add("Accept", c.contentType)
+	if c.acceptLanguage != "" {
+		req.Header.Add("Accept-Language", c.acceptLanguage)
+	}
+	if settings.validators != nil && method == "GET" {
+		withValidators(settings.validators)(req)
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
 	return
 }
 
 func (c *Client) request(req *http.Request, body interface{}) (err error) {
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return
-	}
-
-	if resp.StatusCode != 200 {
-		body := struct {
-			Error errorResponse `json:"error"`
-		}{}
+	_, err = c.send(req, body)
+	return
+}
 
-		decoder := json.NewDecoder(resp.Body)
-		err = decoder.Decode(&body)
+// send is request returning the response, whose body is already consumed.
+func (c *Client) send(req *http.Request, body interface{}) (resp *http.Response, err error) {
+	req, cancel := c.withTimeout(req)
+	defer cancel()
+	for attempt := 0; ; attempt++ {
+		if c.limiter != nil {
+			if err = c.limiter.wait(req.Context()); err != nil {
+				return
+			}
+		}
+		resp, err = c.httpClient.Do(req)
 		if err != nil {
-			return errorResponse{
-				Code:    resp.StatusCode,
-				Message: resp.Status,
+			return
+		}
+		retry, rerr := c.retryWithCredential(req, resp, attempt)
+		if rerr != nil {
+			defer resp.Body.Close()
+			return resp, fmt.Errorf("%w, and no other credential: %w", newErrorResponse(req, resp), rerr)
+		}
+		if retry == nil {
+			// Another key is not held back by the Retry-After of this one
+			if c.limiter != nil && resp.StatusCode == http.StatusTooManyRequests {
+				c.limiter.delay(retryAfter(resp.Header, time.Now()))
 			}
+			break
 		}
+		resp.Body.Close()
+		req = retry
+	}
+	defer resp.Body.Close()
+	if err = capture(resp); err != nil {
+		return
+	}
+
+	if resp.StatusCode == http.StatusNotModified {
+		err = ErrNotModified
+		return
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp, newErrorResponse(req, resp)
+	}
 
-		return body.Error
+	if v := callSettingsFrom(req.Context()).validators; v != nil && req.Method == "GET" {
+		*v = validatorsOf(resp.Header)
+	}
+	if body == nil || resp.StatusCode == http.StatusNoContent {
+		return
 	}
+	if w, ok := body.(io.Writer); ok {
+		_, err = io.Copy(w, resp.Body)
+		return
+	}
+	if d, ok := body.(streamDecoder); ok {
+		err = d.decodeStream(resp.Body)
+		return
+	}
+	err = c.codec.Decode(resp.Body, body)
+	return
+}
+
+func (c *Client) get(ctx context.Context, rawurl string, body interface{}) (err error) {
+	return c.do(ctx, "GET", rawurl, nil, body)
+}
 
-	decoder := json.NewDecoder(resp.Body)
-	err = decoder.Decode(&body)
+// do sends in as request body, if given, and decodes the response into out.
+// in is form encoded when it is url.Values, sent as is when it is an io.Reader and JSON encoded otherwise.
+// The response body is copied when out is an io.Writer and JSON decoded otherwise.
+func (c *Client) do(ctx context.Context, method, rawurl string, in, out interface{}, opts ...requestOption) (err error) {
+	_, err = c.doResponse(ctx, method, rawurl, in, out, opts...)
 	return
 }
 
+// doResponse is do returning the response for its headers.
+func (c *Client) doResponse(ctx context.Context, method, rawurl string, in, out interface{}, opts ...requestOption) (resp *http.Response, err error) {
+	var body io.Reader
+	switch v := in.(type) {
+	case nil:
+	case url.Values:
+		body = strings.NewReader(v.Encode())
+		opts = append([]requestOption{withContentType(contentTypeForm)}, opts...)
+	case io.Reader:
+		body = v
+	default:
+		body, err = c.encodeJSON(in)
+		if err != nil {
+			return
+		}
+	}
+	req, err := c.newRequest(ctx, method, rawurl, body, opts...)
+	if err != nil {
+		return
+	}
+	return c.send(req, out)
+}
+
 func (c *Client) encodeJSON(object interface{}) (reader io.Reader, err error) {
-	buffer := bytes.NewBuffer(nil)
-	encoder := json.NewEncoder(buffer)
-	err = encoder.Encode(object)
+	b, err := c.codec.Marshal(object)
 	if err != nil {
 		return
 	}
 
-	reader = buffer
+	reader = bytes.NewReader(b)
 	return
 }
 
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ClientData represent a toggl client, the customer projects belong to
+type ClientData struct {
+	ID          int       `json:"id"`
+	WorkspaceID int       `json:"wid"`
+	Name        string    `json:"name"`
+	Notes       string    `json:"notes,omitempty"`
+	At          time.Time `json:"at,omitzero"`
+}
+
+// ClientsService handles client endpoints
+type ClientsService struct {
+	client *Client
+}
+
+// List returns clients of the workspace.
+// Results are cached as described by WithLookupCache.
+func (s *ClientsService) List(ctx context.Context, workspaceID int) ([]ClientData, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if clients, ok := s.client.lookups.clients(workspaceID); ok && callSettingsFrom(ctx).validators == nil {
+		return clients, nil
+	}
+
+	var clients []ClientData
+	err = s.client.getCached(ctx, fmt.Sprintf("%s/%d/clients", endpointWorkspaces, workspaceID), &clients)
+	if err != nil {
+		return nil, err
+	}
+	s.client.lookups.setClients(workspaceID, clients)
+	return clients, nil
+}
+
+// Create creates the client in its workspace, the default workspace when WorkspaceID is 0.
+func (s *ClientsService) Create(ctx context.Context, c *ClientData) (*ClientData, error) {
+	in := *c
+	workspaceID, err := s.client.workspace(in.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	in.WorkspaceID = workspaceID
+	body := struct {
+		Data *ClientData `json:"data"`
+	}{}
+	err = s.client.do(ctx, "POST", endpointClients, struct {
+		Client *ClientData `json:"client"`
+	}{&in}, &body)
+	if err != nil {
+		return nil, err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return body.Data, nil
+}
+
+// Update updates the client, which must have an ID. Its workspace is the default workspace when WorkspaceID is 0.
+func (s *ClientsService) Update(ctx context.Context, c *ClientData) (*ClientData, error) {
+	if c.ID == 0 {
+		return nil, ErrIdUnset
+	}
+	in := *c
+	workspaceID, err := s.client.workspace(in.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	in.WorkspaceID = workspaceID
+	body := struct {
+		Data *ClientData `json:"data"`
+	}{}
+	err = s.client.do(ctx, "PUT", fmt.Sprintf("%s/%d", endpointClients, in.ID), struct {
+		Client *ClientData `json:"client"`
+	}{&in}, &body)
+	if err != nil {
+		return nil, err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return body.Data, nil
+}
+
+// Delete deletes the client of the workspace.
+func (s *ClientsService) Delete(ctx context.Context, workspaceID, id int) error {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return err
+	}
+	err = s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", endpointClients, id), nil, nil)
+	if err != nil {
+		return err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return nil
+}
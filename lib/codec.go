@@ -0,0 +1,71 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// Codec encodes request bodies and decodes response bodies of the client.
+// Adapters of other JSON packages, e.g. jsoniter, are given to WithCodec.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+	// Decode decodes the first JSON value read from r into v.
+	Decode(r io.Reader, v interface{}) error
+}
+
+// JSONCodec is the Codec of encoding/json, used by default
+type JSONCodec struct {
+	// Strict fails decoding on fields unknown to the models, to catch changes of the API early.
+	// Fields of types with their own UnmarshalJSON, like TimeEntry, are not checked.
+	Strict bool
+}
+
+// Marshal implements Codec.
+func (c JSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal implements Codec.
+func (c JSONCodec) Unmarshal(data []byte, v interface{}) error {
+	if !c.Strict {
+		return json.Unmarshal(data, v)
+	}
+	return c.Decode(bytes.NewReader(data), v)
+}
+
+// Decode implements Codec.
+func (c JSONCodec) Decode(r io.Reader, v interface{}) error {
+	return c.decoder(r).Decode(v)
+}
+
+func (c JSONCodec) decoder(r io.Reader) *json.Decoder {
+	dec := json.NewDecoder(r)
+	if c.Strict {
+		dec.DisallowUnknownFields()
+	}
+	return dec
+}
+
+// WithCodec sets the Codec of request and response bodies.
+// Pages of the detailed report are decoded while they are read, with encoding/json.
+func WithCodec(codec Codec) Option {
+	return func(c *Client) error {
+		c.codec = codec
+		return nil
+	}
+}
+
+// WithStrictDecoding fails responses with fields unknown to the models, see JSONCodec.Strict.
+func WithStrictDecoding() Option {
+	return WithCodec(JSONCodec{Strict: true})
+}
+
+// jsonDecoder returns the decoder of token streamed responses, strict when the codec is.
+func (c *Client) jsonDecoder(r io.Reader) *json.Decoder {
+	if codec, ok := c.codec.(JSONCodec); ok {
+		return codec.decoder(r)
+	}
+	return json.NewDecoder(r)
+}
@@ -0,0 +1,40 @@
+package client_test
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"testing"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+// pointerCodec is a Codec which, like many JSON packages, only decodes into a pointer to a value.
+type pointerCodec struct {
+	client.JSONCodec
+	t *testing.T
+}
+
+func (c pointerCodec) Decode(r io.Reader, v interface{}) error {
+	if rv := reflect.ValueOf(v); rv.Kind() != reflect.Ptr || rv.Elem().Kind() == reflect.Interface {
+		c.t.Errorf("Decode got %T, want a pointer to the response model", v)
+	}
+	return c.JSONCodec.Decode(r, v)
+}
+
+func TestCodecDecodeTarget(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(client.WithCodec(pointerCodec{t: t}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	me, err := c.Me(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if me == nil || me.DefaultWorkspaceID == 0 {
+		t.Errorf("Me = %+v, want the user of togglmock.FixtureMe", me)
+	}
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// revalidateTTL is how long the validators and body of a response are kept to revalidate it with the API.
+const revalidateTTL = 24 * time.Hour
+
+// revalidateSuffix follows the cache key of a response in the key of its revalidation record.
+const revalidateSuffix = " #revalidate"
+
+// Validators are the ETag and Last-Modified of a response, to ask the API whether it changed since
+type Validators struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+func (v *Validators) empty() bool {
+	return v.ETag == "" && v.LastModified == ""
+}
+
+func validatorsOf(header http.Header) Validators {
+	return Validators{ETag: header.Get("ETag"), LastModified: header.Get("Last-Modified")}
+}
+
+// withValidators makes the request conditional on v.
+func withValidators(v *Validators) requestOption {
+	return func(req *http.Request) {
+		if v.ETag != "" {
+			req.Header.Set("If-None-Match", v.ETag)
+		}
+		if v.LastModified != "" {
+			req.Header.Set("If-Modified-Since", v.LastModified)
+		}
+	}
+}
+
+// Conditional makes the GET requests of the call conditional on v, which is updated from every
+// successful response. When the data did not change since v, the call returns ErrNotModified and
+// leaves its result untouched, so pollers do not download unchanged data again.
+// Calls served by the cache of WithCache return the cached data instead.
+func Conditional(v *Validators) CallOption {
+	return func(s *callSettings) {
+		s.validators = v
+	}
+}
+
+// revalidation is the record kept in the cache to revalidate an expired response
+type revalidation struct {
+	Validators
+	Body json.RawMessage `json:"body"`
+}
+
+func (c *Client) loadRevalidation(key string) (*revalidation, bool) {
+	b, ok := c.cache.Get(key + revalidateSuffix)
+	if !ok {
+		return nil, false
+	}
+	r := &revalidation{}
+	if err := json.Unmarshal(b, r); err != nil || r.empty() {
+		return nil, false
+	}
+	return r, true
+}
+
+func (c *Client) storeRevalidation(key string, header http.Header, body json.RawMessage) {
+	v := validatorsOf(header)
+	if v.empty() {
+		return
+	}
+	b, err := json.Marshal(&revalidation{Validators: v, Body: body})
+	if err == nil {
+		c.cache.Set(key+revalidateSuffix, b, revalidateTTL)
+	}
+}
@@ -0,0 +1,56 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestConditional(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	projects := func(etag string) {
+		server.HandleResponse("GET", "/api/v8/workspaces/*/projects", togglmock.Response{
+			Status: http.StatusOK,
+			Header: http.Header{"ETag": {etag}, "Last-Modified": {"Wed, 08 Jun 2016 09:00:00 GMT"}},
+			Body:   togglmock.FixtureProjects,
+		})
+	}
+	projects(`"v1"`)
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := &client.Validators{}
+	ctx := client.WithCallOptions(context.Background(), client.Conditional(v))
+
+	list, err := c.Projects.List(ctx, 0)
+	if err != nil || len(list) != 2 {
+		t.Fatalf("first List() = %v, %v, want the projects", list, err)
+	}
+	if v.ETag != `"v1"` || v.LastModified != "Wed, 08 Jun 2016 09:00:00 GMT" {
+		t.Errorf("validators after 200 = %+v", v)
+	}
+
+	list, err = c.Projects.List(ctx, 0)
+	if err != client.ErrNotModified || list != nil {
+		t.Errorf("List() of unchanged projects = %v, %v, want ErrNotModified", list, err)
+	}
+	r, _ := server.LastRequest()
+	if r.Header.Get("If-None-Match") != `"v1"` || r.Header.Get("If-Modified-Since") != "Wed, 08 Jun 2016 09:00:00 GMT" {
+		t.Errorf("conditional request headers = %v", r.Header)
+	}
+
+	projects(`"v2"`)
+	if list, err = c.Projects.List(ctx, 0); err != nil || len(list) != 2 || v.ETag != `"v2"` {
+		t.Errorf("List() of changed projects = %v, %v with ETag %s, want the projects and v2", list, err, v.ETag)
+	}
+
+	// Calls without Conditional are not conditional
+	if list, err = c.Projects.List(context.Background(), 0); err != nil || len(list) != 2 {
+		t.Errorf("unconditional List() = %v, %v", list, err)
+	}
+}
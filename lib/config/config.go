@@ -0,0 +1,281 @@
+// Package config loads the API token and default workspace of tools built on the client,
+// from the ~/.togglrc file of the toggl CLI, environment variables or an explicit file.
+//
+// The file is INI formatted like the one of the toggl CLI, and Save keeps its other settings:
+//
+//	[auth]
+//	api_token = 1971800d4d82861d8f2c1651fea4d212
+//
+//	[options]
+//	default_wid = 777
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+)
+
+// Environment variables read by Load
+const (
+	EnvAPIToken    = "TOGGL_API_TOKEN"
+	EnvWorkspaceID = "TOGGL_WORKSPACE_ID"
+)
+
+// FileName is the name of the file in the home directory
+const FileName = ".togglrc"
+
+// ErrNoToken is returned by Load when no API token is configured
+var ErrNoToken = errors.New("no API token configured, set " + EnvAPIToken + " or api_token in ~/" + FileName)
+
+// Config is the configuration of a tool
+type Config struct {
+	APIToken string
+	// WorkspaceID is the default workspace, 0 when not configured
+	WorkspaceID int
+	// Path is the file the configuration is loaded from and saved to
+	Path string
+
+	file *iniFile
+	// envToken and envWorkspaceID are the values Load took from the environment,
+	// which Save does not write to the file
+	envToken       string
+	envWorkspaceID int
+}
+
+// DefaultPath returns the path of ~/.togglrc.
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, FileName), nil
+}
+
+// Load returns the configuration with this precedence, highest first:
+// the file at path when it is not empty, the environment variables and ~/.togglrc.
+// A missing ~/.togglrc is not an error, a missing explicit file is.
+// It returns ErrNoToken, along with the configuration, when none of them has an API token.
+func Load(path string) (*Config, error) {
+	explicit := path != ""
+	if !explicit {
+		var err error
+		if path, err = DefaultPath(); err != nil {
+			return nil, err
+		}
+	}
+	c, err := LoadFile(path)
+	if os.IsNotExist(err) && !explicit {
+		c, err = &Config{Path: path, file: &iniFile{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fileToken, fileWorkspace := c.APIToken, c.WorkspaceID
+	if token := os.Getenv(EnvAPIToken); token != "" {
+		c.APIToken = token
+	}
+	if v := os.Getenv(EnvWorkspaceID); v != "" {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", EnvWorkspaceID, err)
+		}
+		c.WorkspaceID = id
+	}
+	if explicit {
+		if fileToken != "" {
+			c.APIToken = fileToken
+		}
+		if fileWorkspace != 0 {
+			c.WorkspaceID = fileWorkspace
+		}
+	}
+	if c.APIToken != fileToken {
+		c.envToken = c.APIToken
+	}
+	if c.WorkspaceID != fileWorkspace {
+		c.envWorkspaceID = c.WorkspaceID
+	}
+	if c.APIToken == "" {
+		return c, ErrNoToken
+	}
+	return c, nil
+}
+
+// LoadFile returns the configuration of the file only.
+func LoadFile(path string) (*Config, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file := parseINI(string(b))
+	c := &Config{Path: path, file: file}
+	c.APIToken, _ = file.get("auth", "api_token")
+	if v, ok := file.get("options", "default_wid"); ok && v != "" {
+		if c.WorkspaceID, err = strconv.Atoi(v); err != nil {
+			return nil, fmt.Errorf("%s: default_wid: %v", path, err)
+		}
+	}
+	return c, nil
+}
+
+// Save writes the API token and default workspace to Path, ~/.togglrc when empty.
+// Values Load took from the environment are not written unless they were changed since.
+// Other settings and comments of the file are kept. The file is only readable by the user,
+// and replaced at once so a failure leaves the previous one.
+func (c *Config) Save() error {
+	if c.Path == "" {
+		path, err := DefaultPath()
+		if err != nil {
+			return err
+		}
+		c.Path = path
+	}
+	if c.file == nil {
+		c.file = &iniFile{}
+		if b, err := ioutil.ReadFile(c.Path); err == nil {
+			c.file = parseINI(string(b))
+		}
+	}
+	if c.APIToken != "" && c.APIToken != c.envToken {
+		c.file.set("auth", "api_token", c.APIToken)
+	}
+	if c.WorkspaceID != 0 && c.WorkspaceID != c.envWorkspaceID {
+		c.file.set("options", "default_wid", strconv.Itoa(c.WorkspaceID))
+	}
+	return writeFile(c.Path, []byte(c.file.String()))
+}
+
+// writeFile replaces the file at path with a new one of mode 0600.
+func writeFile(path string, b []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// APIKey returns the API key of the token.
+func (c *Config) APIKey() *client.APIKey {
+	return &client.APIKey{Token: c.APIToken, Secret: "api_token"}
+}
+
+// NewClient returns a client of the token, with the default workspace when configured.
+func (c *Config) NewClient(opts ...client.Option) (*client.Client, error) {
+	if c.APIToken == "" {
+		return nil, ErrNoToken
+	}
+	if c.WorkspaceID != 0 {
+		opts = append([]client.Option{client.WithDefaultWorkspace(c.WorkspaceID)}, opts...)
+	}
+	return client.NewClient(c.APIKey(), &client.Resources{}, opts...)
+}
+
+// iniFile keeps the lines of an INI file, to change values without losing the rest.
+type iniFile struct {
+	lines []string
+}
+
+func parseINI(s string) *iniFile {
+	s = strings.TrimSuffix(strings.Replace(s, "\r\n", "\n", -1), "\n")
+	if s == "" {
+		return &iniFile{}
+	}
+	return &iniFile{lines: strings.Split(s, "\n")}
+}
+
+// parseLine returns the section of a section line, or key and value of an entry line.
+func parseLine(line string) (section, key, value string) {
+	line = strings.TrimSpace(line)
+	if line == "" || line[0] == '#' || line[0] == ';' {
+		return
+	}
+	if line[0] == '[' && line[len(line)-1] == ']' {
+		return strings.TrimSpace(line[1 : len(line)-1]), "", ""
+	}
+	if i := strings.IndexAny(line, "=:"); i > 0 {
+		return "", strings.ToLower(strings.TrimSpace(line[:i])), strings.TrimSpace(line[i+1:])
+	}
+	return
+}
+
+// find returns the line of the key in the section, and the last line of the section, -1 when missing.
+func (f *iniFile) find(section, key string) (line, end int) {
+	line, end = -1, -1
+	current := ""
+	for i, l := range f.lines {
+		s, k, _ := parseLine(l)
+		if s != "" {
+			current = s
+			continue
+		}
+		if current != section {
+			continue
+		}
+		if strings.TrimSpace(l) != "" {
+			end = i
+		}
+		if k == key {
+			line = i
+		}
+	}
+	if end == -1 {
+		for i, l := range f.lines {
+			if s, _, _ := parseLine(l); s == section {
+				end = i
+			}
+		}
+	}
+	return
+}
+
+func (f *iniFile) get(section, key string) (string, bool) {
+	line, _ := f.find(section, key)
+	if line < 0 {
+		return "", false
+	}
+	_, _, value := parseLine(f.lines[line])
+	return value, true
+}
+
+func (f *iniFile) set(section, key, value string) {
+	entry := key + " = " + value
+	line, end := f.find(section, key)
+	switch {
+	case line >= 0:
+		f.lines[line] = entry
+	case end >= 0:
+		f.lines = append(f.lines[:end+1], append([]string{entry}, f.lines[end+1:]...)...)
+	default:
+		if len(f.lines) > 0 {
+			f.lines = append(f.lines, "")
+		}
+		f.lines = append(f.lines, "["+section+"]", entry)
+	}
+}
+
+func (f *iniFile) String() string {
+	if len(f.lines) == 0 {
+		return ""
+	}
+	return strings.Join(f.lines, "\n") + "\n"
+}
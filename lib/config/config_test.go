@@ -0,0 +1,115 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const togglrc = `# toggl CLI settings
+[auth]
+api_token = file-token
+
+[options]
+default_wid = 777
+timezone = UTC
+`
+
+func setup(t *testing.T) (home string) {
+	home = t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(EnvAPIToken, "")
+	t.Setenv(EnvWorkspaceID, "")
+	return home
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	home := setup(t)
+	if _, err := Load(""); err != ErrNoToken {
+		t.Errorf("Load without configuration = %v, want ErrNoToken", err)
+	}
+	if err := os.WriteFile(filepath.Join(home, FileName), []byte(togglrc), 0600); err != nil {
+		t.Fatal(err)
+	}
+	explicit := filepath.Join(t.TempDir(), "toggl.ini")
+	if err := os.WriteFile(explicit, []byte("[auth]\napi_token = explicit-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		env         map[string]string
+		path        string
+		token       string
+		workspaceID int
+	}{
+		{"home file", nil, "", "file-token", 777},
+		{"environment over home file", map[string]string{EnvAPIToken: "env-token", EnvWorkspaceID: "888"}, "", "env-token", 888},
+		{"explicit file over environment", map[string]string{EnvAPIToken: "env-token", EnvWorkspaceID: "888"}, explicit, "explicit-token", 888},
+	}
+	for _, tt := range tests {
+		for k, v := range tt.env {
+			t.Setenv(k, v)
+		}
+		c, err := Load(tt.path)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if c.APIToken != tt.token || c.WorkspaceID != tt.workspaceID {
+			t.Errorf("%s: token %q workspace %d, want %q and %d", tt.name, c.APIToken, c.WorkspaceID, tt.token, tt.workspaceID)
+		}
+	}
+
+	if _, err := Load(filepath.Join(home, "missing")); !os.IsNotExist(err) {
+		t.Errorf("Load of a missing explicit file = %v, want a not exist error", err)
+	}
+	t.Setenv(EnvWorkspaceID, "abc")
+	if _, err := Load(""); err == nil {
+		t.Error("Load with an invalid workspace ID succeeded")
+	}
+}
+
+func TestSave(t *testing.T) {
+	home := setup(t)
+	path := filepath.Join(home, FileName)
+	if err := os.WriteFile(path, []byte(togglrc), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(EnvAPIToken, "env-token")
+
+	c, err := Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.WorkspaceID = 999
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := string(b)
+	if strings.Contains(saved, "env-token") || !strings.Contains(saved, "api_token = file-token") {
+		t.Errorf("saved file has the environment token:\n%s", saved)
+	}
+	if !strings.Contains(saved, "default_wid = 999") || !strings.Contains(saved, "timezone = UTC") || !strings.HasPrefix(saved, "# toggl CLI settings") {
+		t.Errorf("saved file lost settings:\n%s", saved)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0600 {
+		t.Errorf("mode = %v, want 0600", mode)
+	}
+
+	c.APIToken = "new-token"
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := LoadFile(path); err != nil || c.APIToken != "new-token" {
+		t.Errorf("LoadFile after changing the token = %+v, %v, want new-token", c, err)
+	}
+}
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxCredentialRetries bounds the number of keys tried for a single request.
+const maxCredentialRetries = 8
+
+// defaultThrottle is how long a key answered with 429 is left out when the response has no Retry-After.
+const defaultThrottle = time.Minute
+
+// CredentialProvider hands out the API keys of requests, e.g. from a secrets manager.
+type CredentialProvider interface {
+	// Credential returns the API key of the next request.
+	Credential(ctx context.Context) (*APIKey, error)
+	// Reject reports the key was answered with 401 or 429, and returns whether the request
+	// should be sent again with the key returned by the next call of Credential.
+	Reject(key *APIKey, resp *http.Response) bool
+}
+
+// WithCredentialProvider sends requests with the API keys of p instead of the key given to NewClient.
+// Requests answered with 401 or 429 are sent again with another key when p allows it.
+// Responses cached by WithCache are kept per key.
+func WithCredentialProvider(p CredentialProvider) Option {
+	return func(c *Client) error {
+		c.credentials = p
+		return nil
+	}
+}
+
+// WithAPIKeys rotates requests between the keys, see RotatingCredentials.
+func WithAPIKeys(keys ...*APIKey) Option {
+	return WithCredentialProvider(NewRotatingCredentials(keys...))
+}
+
+// RotatingCredentials is a CredentialProvider handing out keys in turn.
+// A key answered with 401 is not used anymore, and one answered with 429
+// is left out until its Retry-After.
+type RotatingCredentials struct {
+	mu        sync.Mutex
+	keys      []*APIKey
+	next      int
+	rejected  map[string]bool
+	throttled map[string]time.Time
+}
+
+// NewRotatingCredentials returns a RotatingCredentials of the keys.
+func NewRotatingCredentials(keys ...*APIKey) *RotatingCredentials {
+	return &RotatingCredentials{
+		keys:      keys,
+		rejected:  map[string]bool{},
+		throttled: map[string]time.Time{},
+	}
+}
+
+// Credential implements CredentialProvider. When every usable key is throttled, it returns the one
+// throttled the shortest, and ErrUnauthorized when all of them are rejected.
+func (r *RotatingCredentials) Credential(ctx context.Context) (*APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	var earliest *APIKey
+	for i := 0; i < len(r.keys); i++ {
+		key := r.keys[(r.next+i)%len(r.keys)]
+		if r.rejected[key.Token] {
+			continue
+		}
+		until, ok := r.throttled[key.Token]
+		if !ok || !now.Before(until) {
+			delete(r.throttled, key.Token)
+			r.next = (r.next + i + 1) % len(r.keys)
+			return key, nil
+		}
+		if earliest == nil || until.Before(r.throttled[earliest.Token]) {
+			earliest = key
+		}
+	}
+	if earliest == nil {
+		return nil, ErrUnauthorized
+	}
+	return earliest, nil
+}
+
+// Reject implements CredentialProvider.
+func (r *RotatingCredentials) Reject(key *APIKey, resp *http.Response) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		r.rejected[key.Token] = true
+	case http.StatusTooManyRequests:
+		wait := retryAfter(resp.Header, now)
+		if wait <= 0 {
+			wait = defaultThrottle
+		}
+		r.throttled[key.Token] = now.Add(wait)
+	}
+	for _, k := range r.keys {
+		if until, ok := r.throttled[k.Token]; !r.rejected[k.Token] && (!ok || !now.Before(until)) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryAfter returns the wait of the Retry-After header, in seconds or as a date, 0 when missing.
+func retryAfter(header http.Header, now time.Time) time.Duration {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// credential returns the API key of a request.
+func (c *Client) credential(ctx context.Context) (*APIKey, error) {
+	if c.credentials != nil {
+		return c.credentials.Credential(ctx)
+	}
+	return c.apiKey, nil
+}
+
+// retryWithCredential returns the request to send again with another key after resp, or nil.
+func (c *Client) retryWithCredential(req *http.Request, resp *http.Response, attempt int) (*http.Request, error) {
+	if c.credentials == nil || attempt >= maxCredentialRetries {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusUnauthorized && resp.StatusCode != http.StatusTooManyRequests {
+		return nil, nil
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return nil, nil
+	}
+	token, secret, _ := req.BasicAuth()
+	if !c.credentials.Reject(&APIKey{Token: token, Secret: secret}, resp) {
+		return nil, nil
+	}
+	key, err := c.credentials.Credential(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	c.setAuth(retry, key)
+	return retry, nil
+}
+
+// authHeader is the Authorization header of an API key, kept so polling does not encode it for every request
+type authHeader struct {
+	key   APIKey
+	value []string
+}
+
+// setAuth sets the basic auth header of the key on req.
+func (c *Client) setAuth(req *http.Request, key *APIKey) {
+	if h, ok := c.auth.Load().(*authHeader); ok && h.key == *key {
+		req.Header["Authorization"] = h.value
+		return
+	}
+	req.SetBasicAuth(key.Token, key.Secret)
+	c.auth.Store(&authHeader{key: *key, value: req.Header["Authorization"]})
+}
@@ -0,0 +1,70 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestRateLimitedCredentialRetry(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	server.Inject("GET", "/api/v8/me", togglmock.RateLimited(time.Minute))
+	c, err := server.NewClient(
+		client.WithRateLimit(time.Millisecond),
+		client.WithAPIKeys(&client.APIKey{Token: "first", Secret: "api_token"}, &client.APIKey{Token: "second", Secret: "api_token"}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The other key is tried at once, not after the Retry-After of the first one
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := c.Me(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(server.RequestsTo("GET", "/api/v8/me")); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+// failingCredentials hands out a single key, and fails to hand out another one.
+type failingCredentials struct {
+	err      error
+	rejected bool
+}
+
+func (f *failingCredentials) Credential(ctx context.Context) (*client.APIKey, error) {
+	if f.rejected {
+		return nil, f.err
+	}
+	return &client.APIKey{Token: "only", Secret: "api_token"}, nil
+}
+
+func (f *failingCredentials) Reject(key *client.APIKey, resp *http.Response) bool {
+	f.rejected = true
+	return true
+}
+
+func TestCredentialProviderError(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	server.Inject("GET", "/api/v8/me", togglmock.Error(http.StatusUnauthorized, "bad token"))
+	provider := &failingCredentials{err: errors.New("secrets manager is down")}
+	c, err := server.NewClient(client.WithCredentialProvider(provider))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.Me(context.Background())
+	var resp client.ErrorResponse
+	if !errors.Is(err, provider.err) || !errors.As(err, &resp) || resp.Code != http.StatusUnauthorized {
+		t.Errorf("error = %v, want the 401 response and the provider error", err)
+	}
+}
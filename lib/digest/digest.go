@@ -0,0 +1,274 @@
+// Package digest sends weekly summary reports of clients by email,
+// one digest per client with the time tracked per project over the week.
+package digest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/smtp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+)
+
+// ErrHeaderNewline is returned by SMTPSender for recipients or subjects containing CR or LF,
+// which would inject headers into the mail.
+var ErrHeaderNewline = errors.New("mail header contains a newline")
+
+// Message is a rendered digest ready to send
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Sender sends digests, e.g. SMTPSender
+type Sender interface {
+	Send(ctx context.Context, m *Message) error
+}
+
+// SenderFunc adapts a function to Sender
+type SenderFunc func(ctx context.Context, m *Message) error
+
+// Send calls f.
+func (f SenderFunc) Send(ctx context.Context, m *Message) error {
+	return f(ctx, m)
+}
+
+// SMTPSender sends digests as plain text mails through an SMTP server
+type SMTPSender struct {
+	// Addr is the host:port of the server
+	Addr string
+	// Auth is nil for servers without authentication
+	Auth smtp.Auth
+	From string
+}
+
+// Send sends m. The context is only checked before connecting, net/smtp does not support it.
+func (s *SMTPSender) Send(ctx context.Context, m *Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	msg, err := s.message(m, time.Now())
+	if err != nil {
+		return err
+	}
+	return smtp.SendMail(s.Addr, s.Auth, s.From, m.To, msg)
+}
+
+// message returns m as a mail with CRLF line endings and the Subject encoded as RFC 2047 when not ASCII.
+func (s *SMTPSender) message(m *Message, date time.Time) ([]byte, error) {
+	for _, v := range append([]string{s.From, m.Subject}, m.To...) {
+		if strings.ContainsAny(v, "\r\n") {
+			return nil, ErrHeaderNewline
+		}
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", s.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(m.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	body := strings.ReplaceAll(m.Body, "\r\n", "\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return b.Bytes(), nil
+}
+
+// Recipient receives the digest of a client
+type Recipient struct {
+	ClientID int
+	To       []string
+}
+
+// Project is the time tracked on a project in a digest
+type Project struct {
+	Name     string
+	Duration time.Duration
+	// Entries is the time tracked per description, longest first
+	Entries []Entry
+}
+
+// Entry is the time tracked with a description in a digest
+type Entry struct {
+	Description string
+	Duration    time.Duration
+}
+
+// Data is what the template of a digest is executed with
+type Data struct {
+	Client   string
+	Since    time.Time
+	Until    time.Time
+	Projects []Project
+	Total    time.Duration
+}
+
+// DefaultSubject and DefaultBody are the templates of digests when Scheduler leaves them nil
+var (
+	DefaultSubject = template.Must(template.New("subject").Parse(
+		`Weekly report for {{.Client}}: {{.Since.Format "Jan 2"}} - {{.Until.Format "Jan 2, 2006"}}`))
+	DefaultBody = template.Must(template.New("body").Funcs(template.FuncMap{"hours": hours}).Parse(
+		`Time tracked for {{.Client}} from {{.Since.Format "Mon, Jan 2"}} to {{.Until.Format "Mon, Jan 2, 2006"}}
+
+{{range .Projects}}{{.Name}}: {{hours .Duration}}
+{{range .Entries}}  {{hours .Duration}}  {{.Description}}
+{{end}}
+{{else}}No time tracked this week.
+
+{{end}}Total: {{hours .Total}}
+`))
+)
+
+func hours(d time.Duration) string {
+	d = d.Round(time.Minute)
+	return fmt.Sprintf("%d:%02d", d/time.Hour, d%time.Hour/time.Minute)
+}
+
+// Scheduler renders the digests of the recipients every week and hands them to Sender
+type Scheduler struct {
+	Client *client.Client
+	// WorkspaceID is the workspace of the reports, the default workspace of Client when 0
+	WorkspaceID int
+	Recipients  []Recipient
+	Sender      Sender
+	// Weekday and Hour are when digests of the week before are sent, in Location (UTC when nil)
+	Weekday  time.Weekday
+	Hour     int
+	Location *time.Location
+	// Subject and Body are executed with *Data, DefaultSubject and DefaultBody when nil
+	Subject *template.Template
+	Body    *template.Template
+}
+
+func (s *Scheduler) location() *time.Location {
+	if s.Location == nil {
+		return time.UTC
+	}
+	return s.Location
+}
+
+// Next returns when digests are sent next after now.
+func (s *Scheduler) Next(now time.Time) time.Time {
+	now = now.In(s.location())
+	next := time.Date(now.Year(), now.Month(), now.Day(), s.Hour, 0, 0, 0, now.Location())
+	next = next.AddDate(0, 0, (int(s.Weekday)-int(next.Weekday())+7)%7)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 7)
+	}
+	return next
+}
+
+// Run sends the digests every week until ctx is done, and returns the error of ctx.
+// Failed digests are reported to onError, which may be nil.
+func (s *Scheduler) Run(ctx context.Context, onError func(error)) error {
+	for {
+		next := s.Next(time.Now())
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		day := time.Date(next.Year(), next.Month(), next.Day(), 0, 0, 0, 0, next.Location())
+		if err := s.SendWeek(ctx, day.AddDate(0, 0, -7)); err != nil && onError != nil {
+			onError(err)
+		}
+	}
+}
+
+// SendWeek sends the digests of the 7 days from since to every recipient.
+// It tries all recipients and returns the first error.
+func (s *Scheduler) SendWeek(ctx context.Context, since time.Time) error {
+	names, err := s.clientNames(ctx)
+	if err != nil {
+		return err
+	}
+	var first error
+	for _, r := range s.Recipients {
+		m, err := s.render(ctx, r, names[r.ClientID], since)
+		if err == nil {
+			err = s.Sender.Send(ctx, m)
+		}
+		if err != nil && first == nil {
+			first = fmt.Errorf("digest of client %d: %v", r.ClientID, err)
+		}
+	}
+	return first
+}
+
+// Render returns the digest of the recipient for the 7 days from since.
+func (s *Scheduler) Render(ctx context.Context, r Recipient, since time.Time) (*Message, error) {
+	names, err := s.clientNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.render(ctx, r, names[r.ClientID], since)
+}
+
+func (s *Scheduler) clientNames(ctx context.Context) (map[int]string, error) {
+	clients, err := s.Client.Clients.List(ctx, s.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int]string, len(clients))
+	for _, c := range clients {
+		names[c.ID] = c.Name
+	}
+	return names, nil
+}
+
+func (s *Scheduler) render(ctx context.Context, r Recipient, name string, since time.Time) (*Message, error) {
+	until := since.AddDate(0, 0, 6)
+	report, err := s.Client.Reports.Summary(ctx, &client.ReportParams{
+		WorkspaceID: s.WorkspaceID,
+		Since:       since,
+		Until:       until,
+		ClientIDs:   []int{r.ClientID},
+		Grouping:    "projects",
+		Subgrouping: "time_entries",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = fmt.Sprintf("client %d", r.ClientID)
+	}
+
+	data := &Data{Client: name, Since: since, Until: until, Total: time.Duration(report.TotalGrand) * time.Millisecond}
+	for _, row := range report.Data {
+		p := Project{Name: row.Title.Project, Duration: time.Duration(row.Time) * time.Millisecond}
+		if p.Name == "" {
+			p.Name = "(no project)"
+		}
+		for _, item := range row.Items {
+			p.Entries = append(p.Entries, Entry{Description: item.Title.TimeEntry, Duration: time.Duration(item.Time) * time.Millisecond})
+		}
+		sort.SliceStable(p.Entries, func(i, j int) bool { return p.Entries[i].Duration > p.Entries[j].Duration })
+		data.Projects = append(data.Projects, p)
+	}
+	sort.SliceStable(data.Projects, func(i, j int) bool { return data.Projects[i].Duration > data.Projects[j].Duration })
+
+	subject, body := s.Subject, s.Body
+	if subject == nil {
+		subject = DefaultSubject
+	}
+	if body == nil {
+		body = DefaultBody
+	}
+	var sb, bb bytes.Buffer
+	if err := subject.Execute(&sb, data); err != nil {
+		return nil, err
+	}
+	if err := body.Execute(&bb, data); err != nil {
+		return nil, err
+	}
+	return &Message{To: r.To, Subject: sb.String(), Body: bb.String()}, nil
+}
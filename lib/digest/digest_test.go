@@ -0,0 +1,116 @@
+package digest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestNext(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	for _, tc := range []struct {
+		name     string
+		location *time.Location
+		now      time.Time
+		want     time.Time
+	}{
+		{"day before", nil, time.Date(2016, 6, 5, 10, 0, 0, 0, time.UTC), time.Date(2016, 6, 6, 9, 0, 0, 0, time.UTC)},
+		{"same day before the hour", nil, time.Date(2016, 6, 6, 8, 59, 0, 0, time.UTC), time.Date(2016, 6, 6, 9, 0, 0, 0, time.UTC)},
+		{"at the hour", nil, time.Date(2016, 6, 6, 9, 0, 0, 0, time.UTC), time.Date(2016, 6, 13, 9, 0, 0, 0, time.UTC)},
+		{"same day after the hour", nil, time.Date(2016, 6, 6, 10, 0, 0, 0, time.UTC), time.Date(2016, 6, 13, 9, 0, 0, 0, time.UTC)},
+		{"day after", nil, time.Date(2016, 6, 7, 0, 0, 0, 0, time.UTC), time.Date(2016, 6, 13, 9, 0, 0, 0, time.UTC)},
+		// Sunday 23:00 UTC is already Monday 08:00 in Tokyo
+		{"location", tokyo, time.Date(2016, 6, 5, 23, 0, 0, 0, time.UTC), time.Date(2016, 6, 6, 9, 0, 0, 0, tokyo)},
+	} {
+		s := &Scheduler{Weekday: time.Monday, Hour: 9, Location: tc.location}
+		if got := s.Next(tc.now); !got.Equal(tc.want) {
+			t.Errorf("%s: Next(%v) = %v, want %v", tc.name, tc.now, got, tc.want)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	since := time.Date(2016, 6, 6, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name string
+		r    Recipient
+		want Message
+	}{
+		{"known client", Recipient{ClientID: 10, To: []string{"acme@example.com"}}, Message{
+			Subject: "Weekly report for Acme: Jun 6 - Jun 12, 2016",
+			Body: "Time tracked for Acme from Mon, Jun 6 to Sun, Jun 12, 2016\n\n" +
+				"Website: 1:00\n  1:00  Landing page\n\n" +
+				"Support: 1:00\n  1:00  Inbox\n\n" +
+				"Total: 2:00\n",
+		}},
+		{"unknown client", Recipient{ClientID: 99}, Message{
+			Subject: "Weekly report for client 99: Jun 6 - Jun 12, 2016",
+		}},
+	} {
+		s := &Scheduler{Client: c}
+		m, err := s.Render(context.Background(), tc.r, since)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Subject != tc.want.Subject {
+			t.Errorf("%s: Subject = %q, want %q", tc.name, m.Subject, tc.want.Subject)
+		}
+		if tc.want.Body != "" && m.Body != tc.want.Body {
+			t.Errorf("%s: Body = %q, want %q", tc.name, m.Body, tc.want.Body)
+		}
+		if len(m.To) != len(tc.r.To) {
+			t.Errorf("%s: To = %v, want %v", tc.name, m.To, tc.r.To)
+		}
+	}
+
+	server.Handle("GET", "/reports/api/v2/summary", 200, `{"total_grand":0,"data":[]}`)
+	m, err := (&Scheduler{Client: c}).Render(context.Background(), Recipient{ClientID: 11}, since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(m.Body, "No time tracked this week.\n\nTotal: 0:00\n") {
+		t.Errorf("Body of an empty week = %q", m.Body)
+	}
+}
+
+func TestMessage(t *testing.T) {
+	s := &SMTPSender{From: "toggl@example.com"}
+	date := time.Date(2016, 6, 13, 9, 0, 0, 0, time.UTC)
+	b, err := s.message(&Message{
+		To:      []string{"a@example.com", "b@example.com"},
+		Subject: "Weekly report for Café",
+		Body:    "line 1\nline 2\r\nline 3\n",
+	}, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "From: toggl@example.com\r\n" +
+		"To: a@example.com, b@example.com\r\n" +
+		"Subject: =?utf-8?q?Weekly_report_for_Caf=C3=A9?=\r\n" +
+		"Date: Mon, 13 Jun 2016 09:00:00 +0000\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n\r\n" +
+		"line 1\r\nline 2\r\nline 3\r\n"
+	if string(b) != want {
+		t.Errorf("message = %q, want %q", b, want)
+	}
+
+	for _, m := range []*Message{
+		{To: []string{"a@example.com"}, Subject: "Acme\r\nBcc: c@example.com"},
+		{To: []string{"a@example.com\nBcc: c@example.com"}, Subject: "Acme"},
+	} {
+		if _, err := s.message(m, date); err != ErrHeaderNewline {
+			t.Errorf("message(%q, %q) = %v, want ErrHeaderNewline", m.To, m.Subject, err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// Do calls an endpoint the client does not wrap yet and decodes the JSON response into T.
+// resource is an absolute URL, or a path relative to the API v9 like "/me/projects".
+// params is the query, url.Values or map[string]string, and may be nil. body is sent like the
+// request bodies of the services: form encoded when url.Values, as is when an io.Reader and
+// JSON encoded otherwise. The request goes through the client like the ones of the services,
+// with its credentials, base URL, rate limit and ErrorResponse on failure.
+// T is left zero for empty responses.
+func Do[T any](ctx context.Context, c *Client, method, resource string, params, body any) (T, error) {
+	var out T
+	rawurl := resource
+	if strings.HasPrefix(resource, "/") {
+		rawurl = endpointV9 + resource
+	}
+	query, err := queryValues(params)
+	if err != nil {
+		return out, err
+	}
+	if len(query) > 0 {
+		sep := "?"
+		if strings.Contains(rawurl, "?") {
+			sep = "&"
+		}
+		rawurl += sep + query.Encode()
+	}
+	err = c.do(ctx, method, rawurl, body, &out)
+	if err == io.EOF {
+		// the response has no body, e.g. of a DELETE
+		err = nil
+	}
+	return out, err
+}
+
+func queryValues(params any) (url.Values, error) {
+	switch p := params.(type) {
+	case nil:
+		return nil, nil
+	case url.Values:
+		return p, nil
+	case map[string]string:
+		v := url.Values{}
+		for key, value := range p {
+			v.Set(key, value)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("params must be url.Values or map[string]string, not %T", params)
+}
@@ -0,0 +1,53 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestDo(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	type favorite struct {
+		ID          int    `json:"favorite_id"`
+		Description string `json:"description"`
+	}
+	favorites, err := client.Do[[]favorite](ctx, c, "GET", "/workspaces/1/favorites", map[string]string{"since": "1464000000"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(favorites) != 2 || favorites[0].ID != 41 || favorites[1].Description != "Landing page" {
+		t.Errorf("Do() = %+v, want the favorites of togglmock.FixtureFavorites", favorites)
+	}
+	if r, ok := server.LastRequest(); !ok || r.Path != "/api/v9/workspaces/1/favorites" || r.Query.Get("since") != "1464000000" {
+		t.Errorf("request = %+v, want the v9 favorites with since", r)
+	}
+
+	deleted, err := client.Do[*struct{}](ctx, c, "DELETE", "https://www.toggl.com/api/v8/projects/102", url.Values{}, nil)
+	if err != nil || deleted != nil {
+		t.Errorf("Do() of an empty response = %v, %v, want the zero value", deleted, err)
+	}
+
+	server.InjectError("GET", "/api/v9/workspaces/1/favorites", http.StatusForbidden, "Forbidden")
+	_, err = client.Do[[]favorite](ctx, c, "GET", "/workspaces/1/favorites", nil, nil)
+	var resp client.ErrorResponse
+	if !errors.As(err, &resp) || resp.Code != http.StatusForbidden || resp.Message != "Forbidden" || resp.Method != "GET" {
+		t.Errorf("Do() error = %#v, want the ErrorResponse of the 403", err)
+	}
+
+	if _, err := client.Do[[]favorite](ctx, c, "GET", "/workspaces/1/favorites", 42, nil); err == nil {
+		t.Error("Do() accepted params of type int")
+	}
+}
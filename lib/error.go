@@ -1,19 +1,99 @@
 package client
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
 )
 
 var (
-	ErrMaybeRegistered = errors.New("This record is maybe registered at Gehirn DNS.  Use `UpdateResource(IRecord) error` insted of this method")
-	ErrIdUnset         = errors.New("Record id is unset")
+	ErrMaybeRegistered  = errors.New("This record is maybe registered at Gehirn DNS.  Use `UpdateResource(IRecord) error` insted of this method")
+	ErrIdUnset          = errors.New("Record id is unset")
+	ErrUnauthorized     = errors.New("API token is rejected by toggl")
+	ErrInvalidSignature = errors.New("Webhook signature does not match the payload")
+	ErrNoRunningEntry   = errors.New("No time entry is running")
+	ErrNoEntry          = errors.New("No time entry is found")
+	ErrNotModified      = errors.New("Resource is not modified since the given validators")
+	ErrNoWorkspaceUser  = errors.New("User is not a member of the workspace")
+	ErrNoData           = errors.New("Response has no data")
+	ErrForecastHistory  = errors.New("Forecast history must be at least one day")
+	ErrForecastAlpha    = errors.New("Forecast alpha must be greater than 0 and at most 1")
+	ErrNoWorkspace      = errors.New("Workspace id is unset and the client has no default workspace.  Use WithDefaultWorkspace or Autodiscover")
 )
 
-type errorResponse struct {
+// ErrorResponse is the error returned for a non 2xx response.
+// Message is translated by toggl into the language given by WithAcceptLanguage.
+type ErrorResponse struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+	// Language is the Content-Language of the response, the language Message is written in
+	Language string `json:"-"`
+	// Method and Endpoint are the method and URL path of the failed request
+	Method   string `json:"-"`
+	Endpoint string `json:"-"`
+	// Body is the response body as received, up to maxErrorBody bytes, e.g. the HTML page of a proxy
+	Body []byte `json:"-"`
 }
 
-func (err errorResponse) Error() string {
-	return err.Message
+func (err ErrorResponse) Error() string {
+	if err.Method == "" {
+		return err.Message
+	}
+	return fmt.Sprintf("%s %s: %s", err.Method, err.Endpoint, err.Message)
+}
+
+// Retryable reports whether the request may succeed when sent again later:
+// on rate limits, timeouts and unavailable or failing servers.
+func (err ErrorResponse) Retryable() bool {
+	switch err.Code {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+		http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// maxErrorBody is the size of error bodies kept in ErrorResponse
+const maxErrorBody = 64 << 10
+
+// maxTextMessage is the length of plain text bodies taken as Message
+const maxTextMessage = 200
+
+// newErrorResponse reads the error of a non 2xx response. Toggl answers with
+// {"error":{...}} on the API v8, a JSON string on the API v9 and plain text on some failures.
+func newErrorResponse(req *http.Request, resp *http.Response) ErrorResponse {
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	e := ErrorResponse{
+		Code:     resp.StatusCode,
+		Language: resp.Header.Get("Content-Language"),
+		Method:   req.Method,
+		Endpoint: req.URL.Path,
+		Body:     raw,
+	}
+
+	body := struct {
+		Error ErrorResponse `json:"error"`
+	}{}
+	var message string
+	switch {
+	case json.Unmarshal(raw, &body) == nil:
+		if body.Error.Code != 0 {
+			e.Code = body.Error.Code
+		}
+		e.Message = body.Error.Message
+	case json.Unmarshal(raw, &message) == nil:
+		e.Message = message
+	case !strings.Contains(resp.Header.Get("Content-Type"), "html"):
+		if text := string(bytes.TrimSpace(raw)); len(text) <= maxTextMessage && !strings.Contains(text, "\n") {
+			e.Message = text
+		}
+	}
+	if e.Message == "" {
+		e.Message = resp.Status
+	}
+	return e
 }
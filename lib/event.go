@@ -0,0 +1,49 @@
+package client
+
+import "time"
+
+// Event is delivered to the handler given by WithEventHandler.
+// Switch on the concrete type, e.g. *CacheEvent, to inspect it.
+type Event interface {
+	eventName() string
+}
+
+// WithEventHandler calls handler for every event of the client.
+// The handler is called synchronously from the goroutine making the request.
+func WithEventHandler(handler func(Event)) Option {
+	return func(c *Client) error {
+		c.onEvent = handler
+		return nil
+	}
+}
+
+func (c *Client) emit(e Event) {
+	if c.onEvent != nil {
+		c.onEvent(e)
+	}
+}
+
+// CacheDecision is what the cache did with a request
+type CacheDecision string
+
+// Cache decisions
+const (
+	CacheHit   CacheDecision = "hit"
+	CacheMiss  CacheDecision = "miss"
+	CacheStore CacheDecision = "store"
+	CacheSkip  CacheDecision = "skip"
+	// CacheRevalidate is a response served from the cache after the API answered 304 Not Modified
+	CacheRevalidate CacheDecision = "revalidate"
+)
+
+// CacheEvent reports a decision of the response cache
+type CacheEvent struct {
+	Decision CacheDecision
+	URL      string
+	// TTL is how long a stored response is kept
+	TTL time.Duration
+	// Reason tells where TTL came from or why the response was not stored
+	Reason string
+}
+
+func (e *CacheEvent) eventName() string { return "cache" }
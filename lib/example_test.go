@@ -0,0 +1,368 @@
+package client_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+// The examples run against the fake API of package togglmock.
+// With the real API, create the client with your API token instead:
+//
+//	c, err := client.NewClient(&client.APIKey{Token: token, Secret: "api_token"}, &client.Resources{})
+
+func Example() {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	me, err := c.Autodiscover(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(me.Fullname, c.DefaultWorkspace())
+	// Output: Test User 1
+}
+
+func newExampleClient() (*togglmock.Server, *client.Client) {
+	server := togglmock.NewServer()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		log.Fatal(err)
+	}
+	return server, c
+}
+
+func ExampleTimeEntriesService_StartNow() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	ctx := context.Background()
+	entry, err := c.TimeEntries.StartNow(ctx, "Writing fixtures", 100, []string{"dev"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(entry.Description, entry.IsRunning())
+
+	stopped, err := c.TimeEntries.StopCurrent(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(stopped.Description, stopped.Duration)
+	// Output:
+	// Writing fixtures true
+	// Writing fixtures 1h0m0s
+}
+
+func ExampleTimeEntriesService_Recent() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	suggestions, err := c.TimeEntries.Recent(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, s := range suggestions {
+		fmt.Println(s.Description, s.ProjectID)
+	}
+	// Output:
+	// Landing page 100
+	// Inbox 101
+}
+
+func ExampleTimeEntriesService_Favorites() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	favorites, err := c.TimeEntries.Favorites(context.Background(), 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range favorites {
+		fmt.Println(f.Rank, f.Description)
+	}
+	// Output:
+	// 1 Landing page
+	// 2 Inbox
+}
+
+func ExampleReportsService_EachDetailed() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	params := &client.ReportParams{
+		Since: time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2016, 6, 30, 0, 0, 0, 0, time.UTC),
+	}
+	// Pages are fetched as fn consumes entries. A failure part way returns a
+	// *client.PartialResultError whose Cursor resumes with ResumeDetailed.
+	err := c.Reports.EachDetailed(context.Background(), params, func(e *client.ReportTimeEntry) error {
+		fmt.Println(e.Project, e.Description, time.Duration(e.Dur)*time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// Website Landing page 1h0m0s
+	// Support Inbox 1h0m0s
+}
+
+func ExampleReportsService_Summary() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	report, err := c.Reports.Summary(context.Background(), &client.ReportParams{Grouping: "projects"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, row := range report.Data {
+		fmt.Println(row.Title.Project, time.Duration(row.Time)*time.Millisecond)
+	}
+	// Output:
+	// Website 1h0m0s
+	// Support 1h0m0s
+}
+
+func ExampleReportsService_WeeklyMatrix() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	params := &client.ReportParams{Since: time.Date(2016, 6, 6, 0, 0, 0, 0, time.UTC)}
+	m, err := c.Reports.WeeklyMatrix(context.Background(), params)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, row := range m.Rows {
+		fmt.Println(row.Name(), row.Days[0], row.Days[1], row.Total)
+	}
+	fmt.Println(m.Days[1].Format("Mon Jan 2"), m.DayTotals[1], m.Total)
+	// Output:
+	// Website 1h0m0s 0s 1h0m0s
+	// Support 0s 1h0m0s 1h0m0s
+	// Tue Jun 7 1h0m0s 2h0m0s
+}
+
+func ExampleBatch() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	// One summary per project, two at a time
+	projectIDs := []int{100, 101}
+	reports, err := client.Batch(context.Background(), 2, projectIDs, func(ctx context.Context, id int) (*client.SummaryReport, error) {
+		return c.Reports.Summary(ctx, &client.ReportParams{ProjectIDs: []int{id}})
+	})
+	if err != nil {
+		// A *client.BatchError has the failures by project, reports the other summaries
+		log.Fatal(err)
+	}
+	fmt.Println(len(reports), time.Duration(reports[100].TotalGrand)*time.Millisecond)
+	// Output: 2 2h0m0s
+}
+
+func ExampleReportsV3Service_EachTimeEntries() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	params := &client.ReportsV3SearchParams{
+		ReportsV3Filter: client.ReportsV3Filter{StartDate: "2016-06-01", EndDate: "2016-06-30"},
+	}
+	err := c.ReportsV3.EachTimeEntries(context.Background(), 0, params, func(row *client.ReportsV3Row) error {
+		fmt.Println(row.Description, len(row.TimeEntries))
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// Landing page 1
+	// Inbox 1
+}
+
+func ExampleProjectsService_CreateComplete() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	project, err := c.Projects.CreateComplete(context.Background(), &client.ProjectSpec{
+		Project: client.Project{Name: "Research", EstimatedHours: 40},
+		Tasks:   []client.TaskSpec{{Name: "Kickoff", Estimate: 2 * time.Hour}},
+		Users:   []client.ProjectUserSpec{{UserID: 1000, Manager: true, Rate: 80}},
+	})
+	if err != nil {
+		// A *client.CreateProjectError tells the step which failed; the project was deleted again
+		log.Fatal(err)
+	}
+	fmt.Println(project.Project.Name, len(project.Tasks), len(project.Users))
+	// Output: Research 1 1
+}
+
+func ExampleResolver() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	r := client.NewResolver(c, 0, client.MatchFold)
+	id, err := r.ProjectID(context.Background(), "website")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(id)
+	// Output: 100
+}
+
+func ExampleClientsService_List() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	clients, err := c.Clients.List(context.Background(), 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, cl := range clients {
+		fmt.Println(cl.ID, cl.Name)
+	}
+	// Output:
+	// 10 Acme
+	// 11 Globex
+}
+
+func ExampleTagsService_Create() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	tag, err := c.Tags.Create(context.Background(), &client.Tag{Name: "review"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(tag.ID, tag.Name)
+	// Output: 22 review
+}
+
+func ExampleWorkspacesService_Get() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	w, err := c.Workspaces.Get(context.Background(), 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(w.Name, w.DefaultCurrency)
+	// Output: Test Workspace USD
+}
+
+func ExampleOrganizationsService_List() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	organizations, err := c.Organizations.List(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, o := range organizations {
+		fmt.Println(o.Name, o.UserCount)
+	}
+	// Output: Test Organization 2
+}
+
+func ExampleGroupsService_AddUser() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	ctx := context.Background()
+	if err := c.Groups.AddUser(ctx, 0, 30, 1001); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(len(server.RequestsTo("PUT", "/api/v8/workspace_users/*")))
+	// Output: 1
+}
+
+func ExampleSavedReportsService_Create() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	report, err := c.SavedReports.Create(context.Background(), &client.SavedReport{
+		Name:       "Acme monthly",
+		ReportType: "summary",
+		Public:     true,
+		Params:     client.SavedReportParams{StartDate: "2016-06-01", EndDate: "2016-06-30", ClientIDs: []int{10}},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(report.SharedURL())
+	// Output: https://track.toggl.com/shared-report/abc123
+}
+
+func ExampleWebhooksService_Create() {
+	server, c := newExampleClient()
+	defer server.Close()
+
+	subscription, err := c.Webhooks.Create(context.Background(), 0, &client.WebhookSubscription{
+		Description: "entries",
+		URLCallback: "https://example.com/hook",
+		Enabled:     true,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(subscription.ID, subscription.Secret)
+	// Output: 7 webhook-secret
+}
+
+func ExampleParseWebhook() {
+	const secret = "webhook-secret"
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event, err := client.ParseWebhook(r, secret)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if event.IsTimeEntry() {
+			entry, err := event.TimeEntry()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			fmt.Println(event.Metadata.Action, entry.Description, entry.Duration)
+		}
+	})
+
+	// A delivery as toggl sends it, signed with the secret of the subscription
+	body := `{"event_id":1,"metadata":{"action":"updated","model":"time_entry"},
+ "payload":{"id":5000,"workspace_id":1,"description":"Writing fixtures",
+  "start":"2016-06-09T01:00:00Z","stop":"2016-06-09T02:00:00Z","duration":3600}}`
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	r := httptest.NewRequest("POST", "/hook", strings.NewReader(body))
+	r.Header.Set(client.WebhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	// Output: updated Writing fixtures 1h0m0s
+}
+
+func ExampleWithStrictDecoding() {
+	server := togglmock.NewServer()
+	defer server.Close()
+	// The API added a field the models do not know yet
+	server.Handle("GET", "/api/v8/workspaces/*/tags", http.StatusOK, `[{"id":20,"wid":1,"name":"dev","color":"red"}]`)
+	c, err := server.NewClient(client.WithDefaultWorkspace(1), client.WithStrictDecoding())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = c.Tags.List(context.Background(), 0)
+	fmt.Println(err)
+	// Output: json: unknown field "color"
+}
@@ -0,0 +1,56 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/export"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func ExampleExport() {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	params := &client.ReportParams{
+		Since: time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC),
+		Until: time.Date(2016, 6, 30, 0, 0, 0, 0, time.UTC),
+	}
+	var buf bytes.Buffer
+	n, err := export.Export(context.Background(), c, params, export.FormatCSV, &buf)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(n, strings.Count(buf.String(), "\n"))
+	// Output: 2 3
+}
+
+func ExampleImporter_Import() {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// "Landing page" is already in the workspace, see togglmock.FixtureTimeEntries
+	file := `{"schema_version":1,"kind":"time_entry","id":1,"project":"Website","description":"Landing page","start":"2016-06-08T02:00:00Z","stop":"2016-06-08T04:00:00Z","duration_seconds":7200,"billable":true,"tags":["dev"]}
+{"schema_version":1,"kind":"time_entry","id":2,"project":"Website","description":"Wireframes","start":"2016-06-08T05:00:00Z","stop":"2016-06-08T06:00:00Z","duration_seconds":3600,"billable":true,"tags":[]}
+`
+	im := &export.Importer{Client: c}
+	result, err := im.Import(context.Background(), strings.NewReader(file), export.FormatJSONL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(result.Created, result.Duplicates, result.Skipped)
+	// Output: 1 1 0
+}
@@ -0,0 +1,130 @@
+// Package export writes time entries to CSV or JSON Lines files and imports them back,
+// for backups and moving entries between workspaces.
+// Records are the time entries of package schema, so both formats carry the same fields.
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/schema"
+)
+
+// Format is the file format of an export
+type Format string
+
+// Formats of exports
+const (
+	FormatCSV   Format = "csv"
+	FormatJSONL Format = "jsonl"
+)
+
+// FormatFromPath returns the format of a file name by its extension.
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".jsonl", ".ndjson", ".json":
+		return FormatJSONL, nil
+	}
+	return "", fmt.Errorf("unknown export format of %s", path)
+}
+
+// csvHeader are the columns of CSV exports. Tags are joined with tagSeparator.
+var csvHeader = []string{
+	"id", "workspace_id", "project_id", "project", "client", "user_id", "user",
+	"description", "start", "stop", "duration_seconds", "billable", "tags",
+}
+
+const tagSeparator = ";"
+
+// Export writes the time entries of the detailed report to w, fetching it page by page.
+// Blank project and client names of archived and deleted projects are filled by a client.NameBackfiller.
+// It returns the number of entries written. A failure part way is a *client.PartialResultError.
+func Export(ctx context.Context, c *client.Client, params *client.ReportParams, format Format, w io.Writer) (int, error) {
+	write, flush, err := recordWriter(format, w)
+	if err != nil {
+		return 0, err
+	}
+	workspaceID := params.WorkspaceID
+	if workspaceID == 0 {
+		workspaceID = c.DefaultWorkspace()
+	}
+
+	names := client.NewNameBackfiller(c, workspaceID)
+	n := 0
+	err = c.Reports.EachDetailed(ctx, params, func(e *client.ReportTimeEntry) error {
+		rows := []client.ReportTimeEntry{*e}
+		if err := names.Fill(ctx, rows); err != nil {
+			return err
+		}
+		rec := schema.FromReportTimeEntry(&rows[0])
+		rec.WorkspaceID = workspaceID
+		if err := write(&rec); err != nil {
+			return err
+		}
+		n++
+		return nil
+	})
+	if ferr := flush(); err == nil {
+		err = ferr
+	}
+	return n, err
+}
+
+func recordWriter(format Format, w io.Writer) (write func(*schema.TimeEntry) error, flush func() error, err error) {
+	switch format {
+	case FormatJSONL:
+		enc := json.NewEncoder(w)
+		return func(rec *schema.TimeEntry) error { return enc.Encode(rec) }, func() error { return nil }, nil
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(csvHeader); err != nil {
+			return nil, nil, err
+		}
+		write = func(rec *schema.TimeEntry) error { return cw.Write(csvRecord(rec)) }
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+		return write, flush, nil
+	}
+	return nil, nil, fmt.Errorf("unknown export format %q", format)
+}
+
+func itoa(i int) string {
+	if i == 0 {
+		return ""
+	}
+	return strconv.Itoa(i)
+}
+
+func csvRecord(rec *schema.TimeEntry) []string {
+	stop := ""
+	if rec.Stop != nil {
+		stop = rec.Stop.Format(time.RFC3339)
+	}
+	return []string{
+		strconv.FormatInt(rec.ID, 10),
+		itoa(rec.WorkspaceID),
+		itoa(rec.ProjectID),
+		rec.Project,
+		rec.Client,
+		itoa(rec.UserID),
+		rec.User,
+		rec.Description,
+		rec.Start.Format(time.RFC3339),
+		stop,
+		strconv.FormatInt(rec.DurationSeconds, 10),
+		strconv.FormatBool(rec.Billable),
+		strings.Join(rec.Tags, tagSeparator),
+	}
+}
@@ -0,0 +1,288 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/schema"
+)
+
+// Read calls fn with every record of an export read from r.
+// It stops at the first error of fn, which is returned as is.
+func Read(r io.Reader, format Format, fn func(*schema.TimeEntry) error) error {
+	switch format {
+	case FormatJSONL:
+		return readJSONL(r, fn)
+	case FormatCSV:
+		return readCSV(r, fn)
+	}
+	return fmt.Errorf("unknown export format %q", format)
+}
+
+func readJSONL(r io.Reader, fn func(*schema.TimeEntry) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		rec := &schema.TimeEntry{}
+		if err := json.Unmarshal(scanner.Bytes(), rec); err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+		if err := rec.Check(); err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func readCSV(r io.Reader, fn func(*schema.TimeEntry) error) error {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return err
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[name] = i
+	}
+	for _, name := range []string{"description", "start"} {
+		if _, ok := columns[name]; !ok {
+			return fmt.Errorf("csv column %s is missing", name)
+		}
+	}
+
+	for line := 2; ; line++ {
+		row, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		rec, err := parseCSVRecord(columns, row)
+		if err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+}
+
+func parseCSVRecord(columns map[string]int, row []string) (*schema.TimeEntry, error) {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return row[i]
+		}
+		return ""
+	}
+	number := func(name string) (int64, error) {
+		s := field(name)
+		if s == "" {
+			return 0, nil
+		}
+		return strconv.ParseInt(s, 10, 64)
+	}
+
+	rec := &schema.TimeEntry{
+		SchemaVersion: schema.Version,
+		Kind:          schema.KindTimeEntry,
+		Project:       field("project"),
+		Client:        field("client"),
+		User:          field("user"),
+		Description:   field("description"),
+		Billable:      field("billable") == "true",
+		Tags:          []string{},
+	}
+	var err error
+	var n int64
+	if rec.ID, err = number("id"); err != nil {
+		return nil, err
+	}
+	if n, err = number("workspace_id"); err != nil {
+		return nil, err
+	}
+	rec.WorkspaceID = int(n)
+	if n, err = number("project_id"); err != nil {
+		return nil, err
+	}
+	rec.ProjectID = int(n)
+	if n, err = number("user_id"); err != nil {
+		return nil, err
+	}
+	rec.UserID = int(n)
+	if rec.DurationSeconds, err = number("duration_seconds"); err != nil {
+		return nil, err
+	}
+	if rec.Start, err = time.Parse(time.RFC3339, field("start")); err != nil {
+		return nil, err
+	}
+	if s := field("stop"); s != "" {
+		stop, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, err
+		}
+		rec.Stop = &stop
+	} else {
+		rec.Running = true
+	}
+	if s := field("tags"); s != "" {
+		rec.Tags = strings.Split(s, tagSeparator)
+	}
+	return rec, nil
+}
+
+// ImportResult counts what Import did with the records
+type ImportResult struct {
+	Created int
+	// Duplicates have the description and start of an entry already in the workspace or the file
+	Duplicates int
+	// Skipped are running entries, which are not imported
+	Skipped int
+	// Unresolved have a project name or ID which matches no project of the workspace,
+	// and are not imported rather than created without their project
+	Unresolved int
+}
+
+// Importer creates the time entries of an export in a workspace
+type Importer struct {
+	Client *client.Client
+	// WorkspaceID is the workspace entries are created in, the default workspace of Client when 0
+	WorkspaceID int
+	// ProjectIDs maps project IDs of the export to projects of the workspace.
+	// Records of other projects are matched by project name. IDs of the export are never sent as is.
+	ProjectIDs map[int]int
+}
+
+// dedupeWindow is the range of time entries listed at once to find duplicates.
+// The time entries endpoint returns at most maxListEntries entries.
+const dedupeWindow = 7 * 24 * time.Hour
+
+var maxListEntries = 1000
+
+// list returns the time entries started from since until until,
+// listing halves of the range while a list may have been cut at maxListEntries.
+func (im *Importer) list(ctx context.Context, since, until time.Time) ([]client.TimeEntry, error) {
+	entries, err := im.Client.TimeEntries.List(ctx, since, until)
+	if err != nil || len(entries) < maxListEntries || until.Sub(since) <= 24*time.Hour {
+		return entries, err
+	}
+	middle := since.Add(until.Sub(since) / 2)
+	first, err := im.list(ctx, since, middle)
+	if err != nil {
+		return nil, err
+	}
+	second, err := im.list(ctx, middle, until)
+	return append(first, second...), err
+}
+
+func dedupeKey(description string, start time.Time) string {
+	return description + "\x00" + start.UTC().Format(time.RFC3339)
+}
+
+// Import reads the export from r and creates its entries, skipping the ones whose
+// description and start are already taken by an entry of the workspace.
+// Existing entries are listed week by week for the weeks records start in.
+func (im *Importer) Import(ctx context.Context, r io.Reader, format Format) (*ImportResult, error) {
+	var records []*schema.TimeEntry
+	err := Read(r, format, func(rec *schema.TimeEntry) error {
+		records = append(records, rec)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &ImportResult{}
+	if len(records) == 0 {
+		return result, nil
+	}
+
+	workspaceID := im.WorkspaceID
+	if workspaceID == 0 {
+		workspaceID = im.Client.DefaultWorkspace()
+	}
+	seen := map[string]bool{}
+	listed := map[time.Time]bool{}
+	for _, rec := range records {
+		window := rec.Start.UTC().Truncate(dedupeWindow)
+		if listed[window] {
+			continue
+		}
+		listed[window] = true
+		existing, err := im.list(ctx, window, window.Add(dedupeWindow))
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range existing {
+			if workspaceID == 0 || e.WorkspaceID == workspaceID {
+				seen[dedupeKey(e.Description, e.Start)] = true
+			}
+		}
+	}
+
+	resolver := client.NewResolver(im.Client, workspaceID, client.MatchExact)
+	for _, rec := range records {
+		if rec.Running || rec.Stop == nil {
+			result.Skipped++
+			continue
+		}
+		key := dedupeKey(rec.Description, rec.Start)
+		if seen[key] {
+			result.Duplicates++
+			continue
+		}
+		projectID, ok, err := im.projectID(ctx, resolver, rec)
+		if err != nil {
+			return result, err
+		}
+		if !ok {
+			result.Unresolved++
+			continue
+		}
+		_, err = im.Client.TimeEntries.Create(ctx, &client.TimeEntry{
+			WorkspaceID: workspaceID,
+			ProjectID:   projectID,
+			Description: rec.Description,
+			Start:       rec.Start,
+			Stop:        *rec.Stop,
+			Duration:    time.Duration(rec.DurationSeconds) * time.Second,
+			Billable:    rec.Billable,
+			Tags:        rec.Tags,
+		})
+		if err != nil {
+			return result, err
+		}
+		seen[key] = true
+		result.Created++
+	}
+	return result, nil
+}
+
+// projectID returns the project of the record in the workspace, 0 for records without project.
+// ok is false when the project of the record is not found.
+func (im *Importer) projectID(ctx context.Context, resolver *client.Resolver, rec *schema.TimeEntry) (id int, ok bool, err error) {
+	if id, ok := im.ProjectIDs[rec.ProjectID]; ok && rec.ProjectID != 0 {
+		return id, true, nil
+	}
+	if rec.Project == "" {
+		return 0, rec.ProjectID == 0, nil
+	}
+	id, err = resolver.ProjectID(ctx, rec.Project)
+	if _, ok := err.(*client.NameError); ok {
+		return 0, false, nil
+	}
+	return id, err == nil, err
+}
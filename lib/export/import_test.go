@@ -0,0 +1,113 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/schema"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestImportProjects(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Projects of togglmock.FixtureProjects are Website (100) and Support (101)
+	file := `{"schema_version":1,"kind":"time_entry","project":"Website","description":"by name","start":"2016-06-08T05:00:00Z","stop":"2016-06-08T06:00:00Z","duration_seconds":3600,"tags":[]}
+{"schema_version":1,"kind":"time_entry","project_id":7,"description":"by mapped ID","start":"2016-06-08T06:00:00Z","stop":"2016-06-08T07:00:00Z","duration_seconds":3600,"tags":[]}
+{"schema_version":1,"kind":"time_entry","description":"no project","start":"2016-06-08T07:00:00Z","stop":"2016-06-08T08:00:00Z","duration_seconds":3600,"tags":[]}
+{"schema_version":1,"kind":"time_entry","project":"Unknown","description":"unknown name","start":"2016-06-08T08:00:00Z","stop":"2016-06-08T09:00:00Z","duration_seconds":3600,"tags":[]}
+{"schema_version":1,"kind":"time_entry","project_id":8,"description":"foreign ID","start":"2016-06-08T09:00:00Z","stop":"2016-06-08T10:00:00Z","duration_seconds":3600,"tags":[]}
+`
+	im := &Importer{Client: c, ProjectIDs: map[int]int{7: 101}}
+	result, err := im.Import(context.Background(), strings.NewReader(file), FormatJSONL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Created != 3 || result.Unresolved != 2 {
+		t.Errorf("result = %+v, want 3 created and 2 unresolved", result)
+	}
+
+	var projects []int
+	for _, r := range server.RequestsTo("POST", "/api/v8/time_entries") {
+		var body struct {
+			TimeEntry client.TimeEntry `json:"time_entry"`
+		}
+		if err := json.Unmarshal(r.Body, &body); err != nil {
+			t.Fatal(err)
+		}
+		projects = append(projects, body.TimeEntry.ProjectID)
+	}
+	if len(projects) != 3 || projects[0] != 100 || projects[1] != 101 || projects[2] != 0 {
+		t.Errorf("created projects = %v, want [100 101 0]", projects)
+	}
+}
+
+func TestImportDedupeWindows(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// "Landing page" at 02:00 on June 8 is entry 4999 of togglmock.FixtureTimeEntries
+	file := `{"schema_version":1,"kind":"time_entry","description":"Landing page","start":"2016-06-08T02:00:00Z","stop":"2016-06-08T04:00:00Z","duration_seconds":7200,"tags":[]}
+{"schema_version":1,"kind":"time_entry","description":"Landing page","start":"2016-06-08T05:00:00Z","stop":"2016-06-08T06:00:00Z","duration_seconds":3600,"tags":[]}
+{"schema_version":1,"kind":"time_entry","description":"Later","start":"2016-06-20T05:00:00Z","stop":"2016-06-20T06:00:00Z","duration_seconds":3600,"tags":[]}
+{"schema_version":1,"kind":"time_entry","description":"Much later","start":"2016-09-01T05:00:00Z","stop":"2016-09-01T06:00:00Z","duration_seconds":3600,"tags":[]}
+`
+	im := &Importer{Client: c}
+	result, err := im.Import(context.Background(), strings.NewReader(file), FormatJSONL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Created != 3 || result.Duplicates != 1 {
+		t.Errorf("result = %+v, want 3 created and 1 duplicate", result)
+	}
+
+	// One list per week a record starts in, each covering its records
+	lists := server.RequestsTo("GET", "/api/v8/time_entries")
+	if len(lists) != 3 {
+		t.Fatalf("lists = %d, want 3", len(lists))
+	}
+	for _, r := range lists {
+		since, err1 := time.Parse(time.RFC3339, r.Query.Get("start_date"))
+		until, err2 := time.Parse(time.RFC3339, r.Query.Get("end_date"))
+		if err1 != nil || err2 != nil || until.Sub(since) != dedupeWindow {
+			t.Errorf("listed %s to %s, want a week", r.Query.Get("start_date"), r.Query.Get("end_date"))
+		}
+	}
+
+	// A list as long as the limit may be cut, so its halves are listed down to a day
+	defer func(n int) { maxListEntries = n }(maxListEntries)
+	maxListEntries = 2
+	server.Reset()
+	result, err = im.Import(context.Background(), strings.NewReader(file), FormatJSONL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(server.RequestsTo("GET", "/api/v8/time_entries")); got != 3*15 {
+		t.Errorf("lists with a limit of 2 = %d, want 15 per week", got)
+	}
+}
+
+func TestReadRejects(t *testing.T) {
+	for _, line := range []string{
+		`{"schema_version":2,"kind":"time_entry","description":"newer","start":"2016-06-08T05:00:00Z"}`,
+		`{"kind":"time_entry","description":"no version","start":"2016-06-08T05:00:00Z"}`,
+		`{"schema_version":1,"kind":"totals","duration_seconds":3600}`,
+	} {
+		err := Read(strings.NewReader(line+"\n"), FormatJSONL, func(*schema.TimeEntry) error { return nil })
+		if err == nil {
+			t.Errorf("Read(%s) returned no error", line)
+		}
+	}
+}
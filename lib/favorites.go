@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Favorite is a time entry pinned for starting again, as in the timer of the web app
+type Favorite struct {
+	ID          int        `json:"favorite_id"`
+	WorkspaceID int        `json:"workspace_id"`
+	UserID      int        `json:"user_id,omitempty"`
+	ProjectID   int        `json:"project_id,omitempty"`
+	TaskID      int        `json:"task_id,omitempty"`
+	Description string     `json:"description"`
+	Billable    bool       `json:"billable"`
+	Tags        []string   `json:"tags,omitempty"`
+	TagIDs      []int      `json:"tag_ids,omitempty"`
+	Rank        int        `json:"rank"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+}
+
+// TimeEntry returns the entry to start the favorite with.
+func (f *Favorite) TimeEntry() *TimeEntry {
+	return &TimeEntry{
+		WorkspaceID: f.WorkspaceID,
+		ProjectID:   f.ProjectID,
+		TaskID:      f.TaskID,
+		Billable:    f.Billable,
+		Description: f.Description,
+		Tags:        f.Tags,
+	}
+}
+
+// Suggestion is a distinct recent time entry for autocompletion of a timer
+type Suggestion struct {
+	WorkspaceID int
+	ProjectID   int
+	TaskID      int
+	Description string
+	Billable    bool
+	Tags        []string
+	// LastStart is the start of the latest entry of the suggestion
+	LastStart time.Time
+	// Count is the number of recent entries of the suggestion
+	Count int
+}
+
+// TimeEntry returns the entry to start the suggestion with.
+func (s *Suggestion) TimeEntry() *TimeEntry {
+	return &TimeEntry{
+		WorkspaceID: s.WorkspaceID,
+		ProjectID:   s.ProjectID,
+		TaskID:      s.TaskID,
+		Billable:    s.Billable,
+		Description: s.Description,
+		Tags:        s.Tags,
+	}
+}
+
+// Favorites returns favorites of the user in the workspace, by rank.
+func (s *TimeEntriesService) Favorites(ctx context.Context, workspaceID int) ([]Favorite, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	var favorites []Favorite
+	err = s.client.get(ctx, fmt.Sprintf("%s/workspaces/%d/favorites", endpointV9, workspaceID), &favorites)
+	sort.SliceStable(favorites, func(i, j int) bool { return favorites[i].Rank < favorites[j].Rank })
+	return favorites, err
+}
+
+// Recent returns distinct entries of the last 9 days, started last first, as the timer of the web app suggests them.
+// Entries are the same when description, project, task, billable and tags match.
+func (s *TimeEntriesService) Recent(ctx context.Context) ([]Suggestion, error) {
+	entries, err := s.List(ctx, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	return Suggestions(entries), nil
+}
+
+// Suggestions returns distinct entries of entries, started last first.
+// Running entries are left out.
+func Suggestions(entries []TimeEntry) []Suggestion {
+	index := map[string]int{}
+	var suggestions []Suggestion
+	for i := range entries {
+		e := &entries[i]
+		if e.IsRunning() {
+			continue
+		}
+		tags := append([]string(nil), e.Tags...)
+		sort.Strings(tags)
+		key := fmt.Sprintf("%d\x00%d\x00%d\x00%t\x00%s\x00%s", e.WorkspaceID, e.ProjectID, e.TaskID, e.Billable, e.Description, strings.Join(tags, "\x00"))
+		if n, ok := index[key]; ok {
+			suggestion := &suggestions[n]
+			suggestion.Count++
+			if e.Start.After(suggestion.LastStart) {
+				suggestion.LastStart = e.Start
+			}
+			continue
+		}
+		index[key] = len(suggestions)
+		suggestions = append(suggestions, Suggestion{
+			WorkspaceID: e.WorkspaceID,
+			ProjectID:   e.ProjectID,
+			TaskID:      e.TaskID,
+			Description: e.Description,
+			Billable:    e.Billable,
+			Tags:        e.Tags,
+			LastStart:   e.Start,
+			Count:       1,
+		})
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].LastStart.After(suggestions[j].LastStart)
+	})
+	return suggestions
+}
@@ -0,0 +1,74 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestFavorites(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	favorites, err := c.TimeEntries.Favorites(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(favorites) != 2 || favorites[0].ID != 40 || favorites[1].ID != 41 {
+		t.Fatalf("favorites = %+v, want 40 then 41 by rank", favorites)
+	}
+	if got := server.RequestsTo("GET", "/api/v9/workspaces/1/favorites"); len(got) != 1 {
+		t.Errorf("requests to the default workspace = %d, want 1", len(got))
+	}
+	e := favorites[0].TimeEntry()
+	if e.WorkspaceID != 1 || e.ProjectID != 100 || !e.Billable || e.Description != "Landing page" || len(e.Tags) != 1 {
+		t.Errorf("TimeEntry() = %+v", e)
+	}
+}
+
+func TestRecent(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	server.Handle("GET", "/api/v8/time_entries", http.StatusOK, `[
+ {"id":1,"wid":1,"pid":100,"description":"Review","billable":true,"tags":["dev","ops"],"start":"2016-06-06T09:00:00Z","duration":3600},
+ {"id":2,"wid":1,"pid":101,"description":"Mail","start":"2016-06-07T09:00:00Z","duration":600},
+ {"id":3,"wid":1,"pid":100,"description":"Review","billable":true,"tags":["ops","dev"],"start":"2016-06-08T09:00:00Z","duration":1800},
+ {"id":4,"wid":1,"pid":100,"description":"Review","start":"2016-06-08T10:00:00Z","duration":1800},
+ {"id":5,"wid":1,"pid":101,"description":"Mail","start":"2016-06-09T09:00:00Z","duration":-1465462800}
+]`)
+	c, err := server.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	suggestions, err := c.TimeEntries.Recent(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := func(d, h int) time.Time { return time.Date(2016, 6, d, h, 0, 0, 0, time.UTC) }
+	want := []struct {
+		description string
+		billable    bool
+		count       int
+		lastStart   time.Time
+	}{
+		{"Review", false, 1, day(8, 10)},
+		{"Review", true, 2, day(8, 9)},
+		{"Mail", false, 1, day(7, 9)},
+	}
+	if len(suggestions) != len(want) {
+		t.Fatalf("suggestions = %+v, want %d", suggestions, len(want))
+	}
+	for i, w := range want {
+		s := suggestions[i]
+		if s.Description != w.description || s.Billable != w.billable || s.Count != w.count || !s.LastStart.Equal(w.lastStart) {
+			t.Errorf("suggestion %d = %+v, want %+v", i, s, w)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// Defaults of ForecastParams
+const (
+	DefaultForecastAlpha   = 0.3
+	DefaultForecastHistory = 28
+)
+
+// ForecastParams tells how to forecast tracked time
+type ForecastParams struct {
+	// WorkspaceID is the workspace of the report, the default workspace of the client when 0
+	WorkspaceID int
+	// Now is the time of the forecast, its location gives the days. time.Now() when zero.
+	Now time.Time
+	// History is the number of days before Now used to learn the daily rate, DefaultForecastHistory when 0
+	History int
+	// Alpha is the smoothing factor between 0 and 1, DefaultForecastAlpha when 0.
+	// Higher values follow the last days more closely.
+	Alpha float64
+	// Budgets are the hours each project may track in the month, by project ID
+	Budgets map[int]time.Duration
+}
+
+// ProjectForecast is the end of month forecast of a project
+type ProjectForecast struct {
+	ProjectID int
+	Project   string
+	// Tracked is the time tracked in the month until now
+	Tracked time.Duration
+	// Daily is the smoothed daily rate
+	Daily time.Duration
+	// Forecast is the time expected to be tracked by the end of the month
+	Forecast time.Duration
+	// Budget is 0 for a project without budget
+	Budget time.Duration
+}
+
+// OverBudget reports whether the forecast exceeds the budget of the project.
+func (f *ProjectForecast) OverBudget() bool {
+	return f.Budget > 0 && f.Forecast > f.Budget
+}
+
+// Smooth returns the simple exponential smoothing of the series, the level after its last value.
+// The level starts from the mean of the series, so a few empty first days do not drag it to zero.
+func Smooth(series []float64, alpha float64) float64 {
+	if len(series) == 0 {
+		return 0
+	}
+	level := 0.0
+	for _, x := range series {
+		level += x
+	}
+	level /= float64(len(series))
+	for _, x := range series {
+		level = alpha*x + (1-alpha)*level
+	}
+	return level
+}
+
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// ForecastMonth forecasts the tracked time of every project by the end of the month of now,
+// from time entries of the detailed report covering the month until now and the days of history before.
+// Days without entries count as zero. Forecasts are ordered longest first.
+// history must be positive and alpha in (0, 1].
+func ForecastMonth(rows []ReportTimeEntry, now time.Time, history int, alpha float64, budgets map[int]time.Duration) ([]ProjectForecast, error) {
+	if history <= 0 {
+		return nil, ErrForecastHistory
+	}
+	if !(alpha > 0 && alpha <= 1) {
+		return nil, ErrForecastAlpha
+	}
+	loc := now.Location()
+	today := day(now)
+	monthStart := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, loc)
+	monthEnd := monthStart.AddDate(0, 1, 0)
+	historyStart := today.AddDate(0, 0, -history)
+	days := int(today.Sub(historyStart).Hours()/24 + 0.5)
+
+	type project struct {
+		forecast ProjectForecast
+		daily    []float64
+	}
+	projects := map[int]*project{}
+	for i := range rows {
+		r := &rows[i]
+		start := r.Start.In(loc)
+		p, ok := projects[r.ProjectID]
+		if !ok {
+			p = &project{forecast: ProjectForecast{ProjectID: r.ProjectID, Project: r.Project}, daily: make([]float64, days)}
+			projects[r.ProjectID] = p
+		}
+		dur := time.Duration(r.Dur) * time.Millisecond
+		if !start.Before(monthStart) && start.Before(monthEnd) {
+			p.forecast.Tracked += dur
+		}
+		if d := int(day(start).Sub(historyStart).Hours()/24 + 0.5); d >= 0 && d < days {
+			p.daily[d] += dur.Hours()
+		}
+	}
+
+	remaining := monthEnd.Sub(today.AddDate(0, 0, 1)).Hours() / 24
+	for id, budget := range budgets {
+		if _, ok := projects[id]; !ok {
+			projects[id] = &project{forecast: ProjectForecast{ProjectID: id}}
+		}
+		projects[id].forecast.Budget = budget
+	}
+	forecasts := make([]ProjectForecast, 0, len(projects))
+	for _, p := range projects {
+		f := p.forecast
+		f.Daily = time.Duration(Smooth(p.daily, alpha) * float64(time.Hour)).Round(time.Second)
+		f.Forecast = (f.Tracked + time.Duration(remaining*float64(f.Daily))).Round(time.Second)
+		forecasts = append(forecasts, f)
+	}
+	sort.Slice(forecasts, func(i, j int) bool {
+		if forecasts[i].Forecast != forecasts[j].Forecast {
+			return forecasts[i].Forecast > forecasts[j].Forecast
+		}
+		return forecasts[i].ProjectID < forecasts[j].ProjectID
+	})
+	return forecasts, nil
+}
+
+// ForecastMonth fetches the detailed report and forecasts the tracked time of every project by the end of the month.
+func (s *ReportsService) ForecastMonth(ctx context.Context, params *ForecastParams) ([]ProjectForecast, error) {
+	p := *params
+	if p.Now.IsZero() {
+		p.Now = time.Now()
+	}
+	if p.History == 0 {
+		p.History = DefaultForecastHistory
+	}
+	if p.Alpha == 0 {
+		p.Alpha = DefaultForecastAlpha
+	}
+	if p.History < 0 {
+		return nil, ErrForecastHistory
+	}
+	if !(p.Alpha > 0 && p.Alpha <= 1) {
+		return nil, ErrForecastAlpha
+	}
+	today := day(p.Now)
+	since := today.AddDate(0, 0, -p.History)
+	if monthStart := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location()); monthStart.Before(since) {
+		since = monthStart
+	}
+
+	var rows []ReportTimeEntry
+	err := s.EachDetailed(ctx, &ReportParams{WorkspaceID: p.WorkspaceID, Since: since, Until: today}, func(e *ReportTimeEntry) error {
+		rows = append(rows, *e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ForecastMonth(rows, p.Now, p.History, p.Alpha, p.Budgets)
+}
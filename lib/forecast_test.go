@@ -0,0 +1,49 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestForecastMonth(t *testing.T) {
+	now := time.Date(2016, 6, 16, 12, 0, 0, 0, time.UTC)
+	var rows []ReportTimeEntry
+	for d := 1; d <= 15; d++ {
+		rows = append(rows, ReportTimeEntry{ProjectID: 100, Project: "Website", Start: time.Date(2016, 6, d, 9, 0, 0, 0, time.UTC), Dur: int64(2 * time.Hour / time.Millisecond)})
+	}
+
+	forecasts, err := ForecastMonth(rows, now, 14, 0.5, map[int]time.Duration{100: 40 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(forecasts) != 1 {
+		t.Fatalf("forecasts = %+v, want one project", forecasts)
+	}
+	f := forecasts[0]
+	if f.Tracked != 30*time.Hour || f.Daily != 2*time.Hour {
+		t.Errorf("tracked %v daily %v, want 30h and 2h", f.Tracked, f.Daily)
+	}
+	// 14 days left after today at 2h a day
+	if f.Forecast != 58*time.Hour || !f.OverBudget() {
+		t.Errorf("forecast %v over budget %v, want 58h over the budget", f.Forecast, f.OverBudget())
+	}
+
+	for _, tt := range []struct {
+		history int
+		alpha   float64
+		err     error
+	}{
+		{0, 0.5, ErrForecastHistory},
+		{-1, 0.5, ErrForecastHistory},
+		{14, 0, ErrForecastAlpha},
+		{14, 1.5, ErrForecastAlpha},
+		{14, -0.1, ErrForecastAlpha},
+	} {
+		if _, err := ForecastMonth(rows, now, tt.history, tt.alpha, nil); err != tt.err {
+			t.Errorf("history %d alpha %v: error %v, want %v", tt.history, tt.alpha, err, tt.err)
+		}
+	}
+	if _, err := ForecastMonth(rows, now, 14, 1, nil); err != nil {
+		t.Errorf("alpha 1: %v", err)
+	}
+}
@@ -0,0 +1,339 @@
+// Package gen generates random but realistic workspaces and time entries,
+// for seeding the fake server of togglmock and fuzz testing report aggregation.
+// The same Config, including Seed, always generates the same data.
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sort"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+// Config tells what to generate. Zero fields take the defaults of DefaultConfig.
+// Negative EntriesPerDay, OverlapRate and TagRate generate no entries, overlaps and tags.
+type Config struct {
+	Seed        int64
+	WorkspaceID int
+	Clients     int
+	Projects    int
+	Tags        int
+	Users       int
+	// Start is the first day entries are generated for, Days the number of days
+	Start time.Time
+	Days  int
+	// EntriesPerDay is the average number of entries per user and day
+	EntriesPerDay float64
+	// OverlapRate is the probability an entry starts before the previous one of the user stops
+	OverlapRate float64
+	// TagRate is the probability an entry has tags
+	TagRate float64
+	// MaxDuration is the longest entry generated, at least a minute
+	MaxDuration time.Duration
+}
+
+// DefaultConfig is a small team tracking a couple of weeks
+var DefaultConfig = Config{
+	Seed:          1,
+	WorkspaceID:   1,
+	Clients:       3,
+	Projects:      8,
+	Tags:          5,
+	Users:         4,
+	Start:         time.Date(2016, 6, 6, 0, 0, 0, 0, time.UTC),
+	Days:          14,
+	EntriesPerDay: 6,
+	OverlapRate:   0.05,
+	TagRate:       0.4,
+	MaxDuration:   3 * time.Hour,
+}
+
+// Workspace is a generated workspace with its metadata
+type Workspace struct {
+	Workspace client.Workspace
+	Clients   []client.ClientData
+	Projects  []client.Project
+	Tags      []client.Tag
+	Users     []string
+}
+
+// Generator generates data from a Config
+type Generator struct {
+	cfg    Config
+	rnd    *rand.Rand
+	nextID int64
+}
+
+var (
+	clientNames  = []string{"Acme", "Globex", "Initech", "Umbrella", "Hooli", "Stark", "Wayne", "Tyrell"}
+	projectNames = []string{"Website", "Mobile app", "Support", "Onboarding", "Billing", "Research", "Infrastructure", "Design system"}
+	tagNames     = []string{"dev", "meeting", "review", "ops", "writing", "billable", "travel", "call"}
+	userNames    = []string{"Aiko", "Ben", "Chidi", "Dana", "Eiji", "Farah", "Goro", "Hana"}
+	activities   = []string{"Standup", "Code review", "Bug fixing", "Planning", "Inbox", "Pairing", "Deploy", "Writing docs"}
+)
+
+// New returns a Generator for cfg.
+func New(cfg Config) *Generator {
+	d := DefaultConfig
+	if cfg.Seed == 0 {
+		cfg.Seed = d.Seed
+	}
+	if cfg.WorkspaceID == 0 {
+		cfg.WorkspaceID = d.WorkspaceID
+	}
+	if cfg.Clients == 0 {
+		cfg.Clients = d.Clients
+	}
+	if cfg.Projects == 0 {
+		cfg.Projects = d.Projects
+	}
+	if cfg.Tags == 0 {
+		cfg.Tags = d.Tags
+	}
+	if cfg.Users == 0 {
+		cfg.Users = d.Users
+	}
+	if cfg.Start.IsZero() {
+		cfg.Start = d.Start
+	}
+	if cfg.Days == 0 {
+		cfg.Days = d.Days
+	}
+	if cfg.EntriesPerDay == 0 {
+		cfg.EntriesPerDay = d.EntriesPerDay
+	}
+	if cfg.EntriesPerDay < 0 {
+		cfg.EntriesPerDay = 0
+	}
+	if cfg.OverlapRate == 0 {
+		cfg.OverlapRate = d.OverlapRate
+	}
+	if cfg.TagRate == 0 {
+		cfg.TagRate = d.TagRate
+	}
+	if cfg.MaxDuration == 0 {
+		cfg.MaxDuration = d.MaxDuration
+	}
+	if cfg.MaxDuration < time.Minute {
+		cfg.MaxDuration = time.Minute
+	}
+	return &Generator{cfg: cfg, rnd: rand.New(rand.NewSource(cfg.Seed)), nextID: 1}
+}
+
+func (g *Generator) id() int64 {
+	id := g.nextID
+	g.nextID++
+	return id
+}
+
+func pick(names []string, i int) string {
+	if i < len(names) {
+		return names[i]
+	}
+	return fmt.Sprintf("%s %d", names[i%len(names)], i/len(names)+1)
+}
+
+// Workspace generates the workspace with its clients, projects and tags.
+func (g *Generator) Workspace() *Workspace {
+	at := g.cfg.Start.AddDate(0, 0, -30)
+	ws := &Workspace{Workspace: client.Workspace{
+		ID:    g.cfg.WorkspaceID,
+		Name:  "Generated Workspace",
+		Admin: true,
+		At:    at,
+	}}
+	for i := 0; i < g.cfg.Clients; i++ {
+		ws.Clients = append(ws.Clients, client.ClientData{
+			ID:          int(g.id()),
+			WorkspaceID: g.cfg.WorkspaceID,
+			Name:        pick(clientNames, i),
+			At:          at,
+		})
+	}
+	for i := 0; i < g.cfg.Projects; i++ {
+		p := client.Project{
+			ID:          int(g.id()),
+			WorkspaceID: g.cfg.WorkspaceID,
+			Name:        pick(projectNames, i),
+			Billable:    g.rnd.Intn(2) == 0,
+			Active:      true,
+			Color:       fmt.Sprint(g.rnd.Intn(15)),
+			At:          at,
+		}
+		if len(ws.Clients) > 0 && g.rnd.Intn(5) > 0 {
+			p.ClientID = ws.Clients[g.rnd.Intn(len(ws.Clients))].ID
+		}
+		ws.Projects = append(ws.Projects, p)
+	}
+	for i := 0; i < g.cfg.Tags; i++ {
+		ws.Tags = append(ws.Tags, client.Tag{
+			ID:          int(g.id()),
+			WorkspaceID: g.cfg.WorkspaceID,
+			Name:        pick(tagNames, i),
+			At:          at,
+		})
+	}
+	for i := 0; i < g.cfg.Users; i++ {
+		ws.Users = append(ws.Users, pick(userNames, i))
+	}
+	return ws
+}
+
+// Stream generates time entries of the workspace day by day and calls fn with each of them,
+// in order of start per user and day. It stops when fn returns false.
+func (g *Generator) Stream(ws *Workspace, fn func(client.TimeEntry) bool) {
+	for day := 0; day < g.cfg.Days; day++ {
+		date := g.cfg.Start.AddDate(0, 0, day)
+		if wd := date.Weekday(); wd == time.Saturday || wd == time.Sunday {
+			continue
+		}
+		for user := range ws.Users {
+			n := g.rnd.Intn(int(2*g.cfg.EntriesPerDay) + 1)
+			cursor := date.Add(time.Duration(8+g.rnd.Intn(3)) * time.Hour)
+			for i := 0; i < n; i++ {
+				duration := time.Duration(1+g.rnd.Int63n(int64(g.cfg.MaxDuration/time.Minute))) * time.Minute
+				start := cursor
+				if i > 0 && g.rnd.Float64() < g.cfg.OverlapRate {
+					start = start.Add(-duration / 2)
+				} else {
+					start = start.Add(time.Duration(g.rnd.Intn(30)) * time.Minute)
+				}
+				if !start.Before(date.AddDate(0, 0, 1)) {
+					// the entries of the day ran past midnight
+					break
+				}
+				stop := start.Add(duration)
+				cursor = stop
+
+				entry := client.TimeEntry{
+					ID:          g.id(),
+					WorkspaceID: g.cfg.WorkspaceID,
+					UserID:      user + 1,
+					Description: activities[g.rnd.Intn(len(activities))],
+					Start:       start,
+					Stop:        stop,
+					Duration:    duration,
+					CreatedWith: "toggl-go/gen",
+					At:          stop,
+				}
+				if len(ws.Projects) > 0 && g.rnd.Intn(10) > 0 {
+					p := ws.Projects[g.rnd.Intn(len(ws.Projects))]
+					entry.ProjectID = p.ID
+					entry.Billable = p.Billable
+				}
+				if len(ws.Tags) > 0 && g.rnd.Float64() < g.cfg.TagRate {
+					count := 1 + g.rnd.Intn(2)
+					if count > len(ws.Tags) {
+						count = len(ws.Tags)
+					}
+					for _, j := range g.rnd.Perm(len(ws.Tags))[:count] {
+						entry.Tags = append(entry.Tags, ws.Tags[j].Name)
+					}
+				}
+				if !fn(entry) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TimeEntries generates all time entries of the workspace.
+func (g *Generator) TimeEntries(ws *Workspace) []client.TimeEntry {
+	var entries []client.TimeEntry
+	g.Stream(ws, func(e client.TimeEntry) bool {
+		entries = append(entries, e)
+		return true
+	})
+	return entries
+}
+
+// ReportTimeEntries converts generated entries into rows of the detailed report.
+func ReportTimeEntries(ws *Workspace, entries []client.TimeEntry) []client.ReportTimeEntry {
+	projects := map[int]client.Project{}
+	for _, p := range ws.Projects {
+		projects[p.ID] = p
+	}
+	clients := map[int]string{}
+	for _, c := range ws.Clients {
+		clients[c.ID] = c.Name
+	}
+
+	rows := make([]client.ReportTimeEntry, 0, len(entries))
+	for _, e := range entries {
+		row := client.ReportTimeEntry{
+			ID:          e.ID,
+			ProjectID:   e.ProjectID,
+			UserID:      e.UserID,
+			Description: e.Description,
+			Start:       e.Start,
+			End:         e.Stop,
+			Updated:     e.At,
+			Dur:         int64(e.Duration / time.Millisecond),
+			UseStop:     true,
+			IsBillable:  e.Billable,
+			Currency:    "USD",
+			Tags:        e.Tags,
+		}
+		if e.UserID > 0 && e.UserID <= len(ws.Users) {
+			row.User = ws.Users[e.UserID-1]
+		}
+		if p, ok := projects[e.ProjectID]; ok {
+			row.Project = p.Name
+			row.Client = clients[p.ClientID]
+		}
+		if row.Tags == nil {
+			row.Tags = []string{}
+		}
+		rows = append(rows, row)
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].Start.After(rows[j].Start) })
+	return rows
+}
+
+// Seed makes the fake server serve the workspace and entries:
+// projects, clients and tags of the workspace, and the entries as a single page detailed report.
+func Seed(server *togglmock.Server, ws *Workspace, entries []client.TimeEntry) error {
+	rows := ReportTimeEntries(ws, entries)
+	var total, billable int64
+	for _, r := range rows {
+		total += r.Dur
+		if r.IsBillable {
+			billable += r.Dur
+		}
+	}
+
+	prefix := fmt.Sprintf("/api/v8/workspaces/%d", ws.Workspace.ID)
+	bodies := []struct {
+		method, pattern string
+		v               interface{}
+	}{
+		{"GET", "/api/v8/workspaces", []client.Workspace{ws.Workspace}},
+		{"GET", prefix, struct {
+			Data client.Workspace `json:"data"`
+		}{ws.Workspace}},
+		{"GET", prefix + "/clients", ws.Clients},
+		{"GET", prefix + "/projects", ws.Projects},
+		{"GET", prefix + "/tags", ws.Tags},
+		{"GET", "/reports/api/v2/details", client.DetailedReport{
+			TotalGrand:    total,
+			TotalBillable: billable,
+			TotalCount:    len(rows),
+			PerPage:       len(rows),
+			Data:          rows,
+		}},
+	}
+	for _, b := range bodies {
+		body, err := json.Marshal(b.v)
+		if err != nil {
+			return err
+		}
+		server.Handle(b.method, b.pattern, http.StatusOK, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package gen
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestGeneratorBounds(t *testing.T) {
+	for _, maxDuration := range []time.Duration{0, 30 * time.Second, time.Minute, 90 * time.Minute} {
+		cfg := Config{Users: 3, Days: 7, MaxDuration: maxDuration}
+		g := New(cfg)
+		ws := g.Workspace()
+		entries := g.TimeEntries(ws)
+		if len(entries) == 0 {
+			t.Fatalf("MaxDuration %v: no entries", maxDuration)
+		}
+
+		limit := maxDuration
+		switch {
+		case maxDuration == 0:
+			limit = DefaultConfig.MaxDuration
+		case maxDuration < time.Minute:
+			limit = time.Minute
+		}
+		for _, e := range entries {
+			if e.Duration < time.Minute || e.Duration > limit {
+				t.Errorf("MaxDuration %v: duration %v out of [1m, %v]", maxDuration, e.Duration, limit)
+			}
+			if !e.Stop.Equal(e.Start.Add(e.Duration)) {
+				t.Errorf("entry %d stops at %v, want start plus duration", e.ID, e.Stop)
+			}
+			if e.UserID < 1 || e.UserID > cfg.Users {
+				t.Errorf("entry %d has user %d, want 1 to %d", e.ID, e.UserID, cfg.Users)
+			}
+			if wd := e.Start.Weekday(); wd == time.Saturday || wd == time.Sunday {
+				t.Errorf("entry %d starts on %v", e.ID, wd)
+			}
+			if last := DefaultConfig.Start.AddDate(0, 0, cfg.Days); !e.Start.Before(last.Add(12 * time.Hour)) {
+				t.Errorf("entry %d starts at %v, after the last day", e.ID, e.Start)
+			}
+		}
+	}
+}
+
+func TestGeneratorDeterministic(t *testing.T) {
+	generate := func(seed int64) []client.TimeEntry {
+		g := New(Config{Seed: seed})
+		return g.TimeEntries(g.Workspace())
+	}
+	if a, b := generate(7), generate(7); !reflect.DeepEqual(a, b) {
+		t.Error("the same seed generated different entries")
+	}
+	if a, b := generate(7), generate(8); reflect.DeepEqual(a, b) {
+		t.Error("different seeds generated the same entries")
+	}
+}
+
+func TestSeed(t *testing.T) {
+	g := New(Config{})
+	ws := g.Workspace()
+	entries := g.TimeEntries(ws)
+	server := togglmock.NewServer()
+	defer server.Close()
+	if err := Seed(server, ws, entries); err != nil {
+		t.Fatal(err)
+	}
+	c, err := server.NewClient(client.WithDefaultWorkspace(ws.Workspace.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	projects, err := c.Projects.List(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(projects) != len(ws.Projects) {
+		t.Errorf("projects = %d, want %d", len(projects), len(ws.Projects))
+	}
+	report, err := c.Reports.Detailed(ctx, &client.ReportParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.TotalCount != len(entries) || len(report.Data) != len(entries) {
+		t.Errorf("report has %d of %d rows, want %d", len(report.Data), report.TotalCount, len(entries))
+	}
+}
+
+func TestGeneratorDefaults(t *testing.T) {
+	generate := func(cfg Config) []client.TimeEntry {
+		g := New(cfg)
+		return g.TimeEntries(g.Workspace())
+	}
+	if a, b := generate(Config{}), generate(DefaultConfig); !reflect.DeepEqual(a, b) {
+		t.Error("the zero Config generated other entries than DefaultConfig")
+	}
+
+	if entries := generate(Config{EntriesPerDay: -1}); len(entries) != 0 {
+		t.Errorf("negative EntriesPerDay generated %d entries, want none", len(entries))
+	}
+	for _, e := range generate(Config{TagRate: -1}) {
+		if len(e.Tags) > 0 {
+			t.Fatalf("negative TagRate generated entry %d with tags %v", e.ID, e.Tags)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Group represent a group (team) of users in a workspace
+type Group struct {
+	ID          int       `json:"id,omitempty"`
+	WorkspaceID int       `json:"wid"`
+	Name        string    `json:"name"`
+	At          time.Time `json:"at,omitzero"`
+}
+
+// WorkspaceUser is a membership of a user in a workspace
+type WorkspaceUser struct {
+	ID          int       `json:"id"`
+	UserID      int       `json:"uid"`
+	WorkspaceID int       `json:"wid"`
+	Admin       bool      `json:"admin"`
+	Active      bool      `json:"active"`
+	Email       string    `json:"email,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	GroupIDs    []int     `json:"group_ids"`
+	At          time.Time `json:"at,omitzero"`
+}
+
+// InGroup reports whether the user is a member of the group.
+func (u *WorkspaceUser) InGroup(groupID int) bool {
+	for _, id := range u.GroupIDs {
+		if id == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupsService handles group and workspace user endpoints
+type GroupsService struct {
+	client *Client
+}
+
+type groupResponse struct {
+	Data *Group `json:"data"`
+}
+
+// List returns groups of the workspace.
+func (s *GroupsService) List(ctx context.Context, workspaceID int) ([]Group, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	var groups []Group
+	err = s.client.getCached(ctx, fmt.Sprintf("%s/%d/groups", endpointWorkspaces, workspaceID), &groups)
+	return groups, err
+}
+
+// Create creates the group in its workspace, the default workspace when WorkspaceID is 0.
+func (s *GroupsService) Create(ctx context.Context, g *Group) (*Group, error) {
+	in := *g
+	workspaceID, err := s.client.workspace(in.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	in.WorkspaceID = workspaceID
+	body := groupResponse{}
+	err = s.client.do(ctx, "POST", endpointGroups, struct {
+		Group *Group `json:"group"`
+	}{&in}, &body)
+	if err != nil {
+		return nil, err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return body.Data, nil
+}
+
+// Rename renames the group of the workspace.
+func (s *GroupsService) Rename(ctx context.Context, workspaceID, groupID int, name string) (*Group, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	body := groupResponse{}
+	err = s.client.do(ctx, "PUT", fmt.Sprintf("%s/%d", endpointGroups, groupID), struct {
+		Group *Group `json:"group"`
+	}{&Group{WorkspaceID: workspaceID, Name: name}}, &body)
+	if err != nil {
+		return nil, err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return body.Data, nil
+}
+
+// Delete deletes the group of the workspace. Its members stay in the workspace.
+func (s *GroupsService) Delete(ctx context.Context, workspaceID, groupID int) error {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return err
+	}
+	err = s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", endpointGroups, groupID), nil, nil)
+	if err != nil {
+		return err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return nil
+}
+
+// Users returns users of the workspace with the groups they are members of.
+func (s *GroupsService) Users(ctx context.Context, workspaceID int) ([]WorkspaceUser, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	var users []WorkspaceUser
+	err = s.client.getCached(ctx, fmt.Sprintf("%s/%d/workspace_users", endpointWorkspaces, workspaceID), &users)
+	return users, err
+}
+
+// Members returns users of the workspace who are members of the group.
+func (s *GroupsService) Members(ctx context.Context, workspaceID, groupID int) ([]WorkspaceUser, error) {
+	users, err := s.Users(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	var members []WorkspaceUser
+	for i := range users {
+		if users[i].InGroup(groupID) {
+			members = append(members, users[i])
+		}
+	}
+	return members, nil
+}
+
+// AddUser adds the user of the workspace to the group.
+// It returns ErrNoWorkspaceUser when the user is not in the workspace.
+func (s *GroupsService) AddUser(ctx context.Context, workspaceID, groupID, userID int) error {
+	return s.updateMembership(ctx, workspaceID, userID, func(u *WorkspaceUser) bool {
+		if u.InGroup(groupID) {
+			return false
+		}
+		u.GroupIDs = append(u.GroupIDs, groupID)
+		return true
+	})
+}
+
+// RemoveUser removes the user of the workspace from the group.
+// It returns ErrNoWorkspaceUser when the user is not in the workspace.
+func (s *GroupsService) RemoveUser(ctx context.Context, workspaceID, groupID, userID int) error {
+	return s.updateMembership(ctx, workspaceID, userID, func(u *WorkspaceUser) bool {
+		ids := u.GroupIDs[:0]
+		for _, id := range u.GroupIDs {
+			if id != groupID {
+				ids = append(ids, id)
+			}
+		}
+		changed := len(ids) != len(u.GroupIDs)
+		u.GroupIDs = ids
+		return changed
+	})
+}
+
+// updateMembership updates the groups of the user when change reports a change.
+func (s *GroupsService) updateMembership(ctx context.Context, workspaceID, userID int, change func(*WorkspaceUser) bool) error {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return err
+	}
+	// The groups are read uncached, so the update does not drop changes made since the cached list
+	var users []WorkspaceUser
+	err = s.client.get(ctx, fmt.Sprintf("%s/%d/workspace_users", endpointWorkspaces, workspaceID), &users)
+	if err != nil {
+		return err
+	}
+	var user *WorkspaceUser
+	for i := range users {
+		if users[i].UserID == userID {
+			user = &users[i]
+			break
+		}
+	}
+	if user == nil {
+		return ErrNoWorkspaceUser
+	}
+	u := *user
+	u.GroupIDs = append([]int{}, user.GroupIDs...)
+	if !change(&u) {
+		return nil
+	}
+	in := struct {
+		GroupIDs []int `json:"group_ids"`
+	}{u.GroupIDs}
+	err = s.client.do(ctx, "PUT", fmt.Sprintf("%s/%d", endpointWorkspaceUsers, u.ID), struct {
+		WorkspaceUser interface{} `json:"workspace_user"`
+	}{in}, nil)
+	if err != nil {
+		return err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return nil
+}
@@ -0,0 +1,58 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestMembershipUncached(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1), client.WithCache(client.NewMemoryCache(), time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := c.Groups.Users(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	// Groups of the other user as changed by someone else after they were cached
+	others := func(groups string) {
+		server.Handle("GET", "/api/v8/workspaces/*/workspace_users", http.StatusOK,
+			fmt.Sprintf(`[{"id":61,"uid":1001,"wid":1,"active":true,"group_ids":%s}]`, groups))
+	}
+	sent := func() string {
+		requests := server.RequestsTo("PUT", "/api/v8/workspace_users/61")
+		var body struct {
+			WorkspaceUser struct {
+				GroupIDs []int `json:"group_ids"`
+			} `json:"workspace_user"`
+		}
+		if err := json.Unmarshal(requests[len(requests)-1].Body, &body); err != nil {
+			t.Fatal(err)
+		}
+		return fmt.Sprint(body.WorkspaceUser.GroupIDs)
+	}
+
+	others("[31]")
+	if err := c.Groups.AddUser(ctx, 0, 32, 1001); err != nil {
+		t.Fatal(err)
+	}
+	if got := sent(); got != "[31 32]" {
+		t.Errorf("groups after AddUser = %s, want [31 32]", got)
+	}
+	others("[31,32,40]")
+	if err := c.Groups.RemoveUser(ctx, 0, 31, 1001); err != nil {
+		t.Fatal(err)
+	}
+	if got := sent(); got != "[32 40]" {
+		t.Errorf("groups after RemoveUser = %s, want [32 40]", got)
+	}
+}
@@ -0,0 +1,189 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// lookupCache keeps project, client and tag lookups per workspace.
+// Every workspace has a data version, the latest `at` seen for any of its objects.
+// When a newer version is observed all lookups of the workspace are dropped at once,
+// so a cached list is never older than anything this client has seen.
+// Lists are only kept when maxAge is positive, and for no longer than maxAge.
+type lookupCache struct {
+	mu         sync.Mutex
+	maxAge     time.Duration
+	workspaces map[int]*workspaceLookups
+}
+
+type workspaceLookups struct {
+	version    time.Time
+	projects   []Project
+	projectsAt time.Time
+	clients    []ClientData
+	clientsAt  time.Time
+	tags       []Tag
+	tagsAt     time.Time
+}
+
+func newLookupCache() *lookupCache {
+	return &lookupCache{workspaces: map[int]*workspaceLookups{}}
+}
+
+// WithLookupCache keeps project, client and tag lists of every workspace in memory for maxAge.
+// Kept lists are dropped early when the client sees a newer change in the workspace.
+// Conditional List calls always go to the API.
+// Without it every List goes to the API, through the response cache of WithCache if any.
+func WithLookupCache(maxAge time.Duration) Option {
+	return func(c *Client) error {
+		c.lookups.maxAge = maxAge
+		return nil
+	}
+}
+
+func (l *lookupCache) fresh(fetched time.Time) bool {
+	return l.maxAge > 0 && !fetched.IsZero() && time.Since(fetched) < l.maxAge
+}
+
+func (l *lookupCache) workspace(id int) *workspaceLookups {
+	w, ok := l.workspaces[id]
+	if !ok {
+		w = &workspaceLookups{}
+		l.workspaces[id] = w
+	}
+	return w
+}
+
+// observe records the given `at` values and drops the lookups if any is newer than the version.
+func (l *lookupCache) observe(id int, ats ...time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.observeLocked(id, ats...)
+}
+
+func (l *lookupCache) observeLocked(id int, ats ...time.Time) {
+	w := l.workspace(id)
+	latest := w.version
+	for _, at := range ats {
+		if at.After(latest) {
+			latest = at
+		}
+	}
+	if latest.After(w.version) {
+		*w = workspaceLookups{version: latest}
+	}
+}
+
+func (l *lookupCache) version(id int) time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if w, ok := l.workspaces[id]; ok {
+		return w.version
+	}
+	return time.Time{}
+}
+
+func (l *lookupCache) invalidate(id int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.workspaces, id)
+}
+
+func (l *lookupCache) projects(id int) ([]Project, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	w, ok := l.workspaces[id]
+	if !ok || w.projects == nil || !l.fresh(w.projectsAt) {
+		return nil, false
+	}
+	return append([]Project(nil), w.projects...), true
+}
+
+func projectAts(projects []Project) []time.Time {
+	ats := make([]time.Time, len(projects))
+	for i, p := range projects {
+		ats[i] = p.At
+	}
+	return ats
+}
+
+func (l *lookupCache) setProjects(id int, projects []Project) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.observeLocked(id, projectAts(projects)...)
+	if l.maxAge <= 0 {
+		return
+	}
+	w := l.workspace(id)
+	w.projects = append([]Project{}, projects...)
+	w.projectsAt = time.Now()
+}
+
+func (l *lookupCache) clients(id int) ([]ClientData, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	w, ok := l.workspaces[id]
+	if !ok || w.clients == nil || !l.fresh(w.clientsAt) {
+		return nil, false
+	}
+	return append([]ClientData(nil), w.clients...), true
+}
+
+func (l *lookupCache) setClients(id int, clients []ClientData) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ats := make([]time.Time, len(clients))
+	for i, cl := range clients {
+		ats[i] = cl.At
+	}
+	l.observeLocked(id, ats...)
+	if l.maxAge <= 0 {
+		return
+	}
+	w := l.workspace(id)
+	w.clients = append([]ClientData{}, clients...)
+	w.clientsAt = time.Now()
+}
+
+func (l *lookupCache) tags(id int) ([]Tag, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	w, ok := l.workspaces[id]
+	if !ok || w.tags == nil || !l.fresh(w.tagsAt) {
+		return nil, false
+	}
+	return append([]Tag(nil), w.tags...), true
+}
+
+func (l *lookupCache) setTags(id int, tags []Tag) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ats := make([]time.Time, len(tags))
+	for i, t := range tags {
+		ats[i] = t.At
+	}
+	l.observeLocked(id, ats...)
+	if l.maxAge <= 0 {
+		return
+	}
+	w := l.workspace(id)
+	w.tags = append([]Tag{}, tags...)
+	w.tagsAt = time.Now()
+}
+
+// WorkspaceVersion returns the latest `at` this client has seen in the workspace.
+func (c *Client) WorkspaceVersion(workspaceID int) time.Time {
+	return c.lookups.version(workspaceID)
+}
+
+// ObserveWorkspaceVersion tells the client that the workspace changed at the given time.
+// Cached lookups of the workspace are dropped when at is newer than the current version.
+func (c *Client) ObserveWorkspaceVersion(workspaceID int, at time.Time) {
+	c.lookups.observe(workspaceID, at)
+}
+
+// InvalidateWorkspace drops all cached lookups and responses of the workspace.
+func (c *Client) InvalidateWorkspace(workspaceID int) {
+	c.lookups.invalidate(workspaceID)
+	c.invalidateCachedWorkspace(workspaceID)
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLookupCache(t *testing.T) {
+	at := time.Date(2016, 6, 8, 3, 0, 0, 0, time.UTC)
+	projects := []Project{{ID: 1, Name: "toggl-go", At: at}}
+
+	off := newLookupCache()
+	off.setProjects(1, projects)
+	if _, ok := off.projects(1); ok {
+		t.Error("projects are kept without a max age")
+	}
+	if got := off.version(1); !got.Equal(at) {
+		t.Errorf("version = %v, want %v", got, at)
+	}
+
+	l := newLookupCache()
+	l.maxAge = time.Hour
+	l.setProjects(1, projects)
+	if got, ok := l.projects(1); !ok || len(got) != 1 {
+		t.Fatalf("projects = %v, %v, want the kept list", got, ok)
+	}
+
+	l.workspaces[1].projectsAt = time.Now().Add(-2 * time.Hour)
+	if _, ok := l.projects(1); ok {
+		t.Error("projects are kept after the max age")
+	}
+
+	l.setProjects(1, projects)
+	l.observe(1, at.Add(time.Minute))
+	if _, ok := l.projects(1); ok {
+		t.Error("projects are kept after a newer version was observed")
+	}
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// User represent the owner of the API token
+type User struct {
+	ID                 int         `json:"id"`
+	APIToken           string      `json:"api_token"`
+	DefaultWorkspaceID int         `json:"default_wid"`
+	Email              string      `json:"email"`
+	Fullname           string      `json:"fullname"`
+	Timezone           string      `json:"timezone"`
+	At                 time.Time   `json:"at"`
+	Workspaces         []Workspace `json:"workspaces,omitempty"`
+}
+
+// WithDefaultWorkspace sets the workspace used by service methods given workspace ID 0.
+func WithDefaultWorkspace(workspaceID int) Option {
+	return func(c *Client) error {
+		c.defaultWorkspace.Store(int64(workspaceID))
+		return nil
+	}
+}
+
+// Me returns the owner of the API token.
+func (c *Client) Me(ctx context.Context) (*User, error) {
+	body := struct {
+		Since int64 `json:"since"`
+		Data  *User `json:"data"`
+	}{}
+	if err := c.get(ctx, endpointMe, &body); err != nil {
+		return nil, err
+	}
+	return body.Data, nil
+}
+
+// Autodiscover sets the default workspace of the client to the default workspace of the user.
+// It returns ErrNoData when the response has no user.
+func (c *Client) Autodiscover(ctx context.Context) (*User, error) {
+	me, err := c.Me(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if me == nil {
+		return nil, ErrNoData
+	}
+	c.defaultWorkspace.Store(int64(me.DefaultWorkspaceID))
+	return me, nil
+}
+
+// DefaultWorkspace returns the default workspace ID, 0 when it is not set.
+func (c *Client) DefaultWorkspace() int {
+	return int(c.defaultWorkspace.Load())
+}
+
+// workspace returns workspaceID, or the default workspace when it is 0.
+func (c *Client) workspace(workspaceID int) (int, error) {
+	if workspaceID != 0 {
+		return workspaceID, nil
+	}
+	if id := c.DefaultWorkspace(); id != 0 {
+		return id, nil
+	}
+	return 0, ErrNoWorkspace
+}
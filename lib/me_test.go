@@ -0,0 +1,30 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestAutodiscover(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Autodiscover(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if c.DefaultWorkspace() == 0 {
+		t.Error("the default workspace is not set")
+	}
+
+	server.Handle("GET", "/api/v8/me", http.StatusOK, `{"since":1464000000}`)
+	if _, err := c.Autodiscover(context.Background()); err != client.ErrNoData {
+		t.Errorf("Autodiscover without user: %v, want ErrNoData", err)
+	}
+}
@@ -0,0 +1,292 @@
+// Package migrate copies the clients, projects, tags and optionally the time entries of a workspace
+// to another one, e.g. when an organization is restructured. Items whose name is already taken in the
+// target workspace are reused instead of created, so an interrupted migration can be run again.
+// Projects are matched by name within their client, so projects of the same name under different
+// clients are kept apart.
+package migrate
+
+import (
+	"context"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+)
+
+// Kinds of migrated items
+const (
+	KindClient    = "client"
+	KindProject   = "project"
+	KindTag       = "tag"
+	KindTimeEntry = "time_entry"
+)
+
+// Operations of actions
+const (
+	OpCreate = "create"
+	OpReuse  = "reuse"
+	OpSkip   = "skip"
+)
+
+// Action is what the migration did, or would do in dry-run mode, with an item of the source workspace.
+// TargetID is 0 for items not created because of dry-run mode.
+type Action struct {
+	Kind     string
+	Op       string
+	Name     string
+	SourceID int64
+	TargetID int64
+}
+
+// Mapping maps IDs of the source workspace to IDs of the target workspace
+type Mapping struct {
+	Clients     map[int]int
+	Projects    map[int]int
+	Tags        map[int]int
+	TimeEntries map[int64]int64
+}
+
+// Result is the outcome of a migration
+type Result struct {
+	Mapping Mapping
+	Actions []Action
+}
+
+// Migration copies a workspace to another. From and To may be the same client.
+type Migration struct {
+	From          *client.Client
+	FromWorkspace int
+	To            *client.Client
+	ToWorkspace   int
+
+	// TimeEntries copies the time entries of the token started between Since and Until as well.
+	// Entries with the description and start of an entry of the target workspace are skipped.
+	TimeEntries bool
+	Since       time.Time
+	Until       time.Time
+
+	// DryRun reports the actions without creating anything
+	DryRun bool
+	// OnAction is called with every action when not nil
+	OnAction func(Action)
+}
+
+type run struct {
+	*Migration
+	result *Result
+}
+
+func (r *run) record(a Action) {
+	r.result.Actions = append(r.result.Actions, a)
+	if r.OnAction != nil {
+		r.OnAction(a)
+	}
+}
+
+// Run runs the migration. On error, the result holds what was done before it.
+func (m *Migration) Run(ctx context.Context) (*Result, error) {
+	r := &run{Migration: m, result: &Result{Mapping: Mapping{
+		Clients:     map[int]int{},
+		Projects:    map[int]int{},
+		Tags:        map[int]int{},
+		TimeEntries: map[int64]int64{},
+	}}}
+	steps := []func(context.Context) error{r.clients, r.projects, r.tags}
+	if m.TimeEntries {
+		steps = append(steps, r.timeEntries)
+	}
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return r.result, err
+		}
+	}
+	return r.result, nil
+}
+
+func (r *run) clients(ctx context.Context) error {
+	source, err := r.From.Clients.List(ctx, r.FromWorkspace)
+	if err != nil {
+		return err
+	}
+	target, err := r.To.Clients.List(ctx, r.ToWorkspace)
+	if err != nil {
+		return err
+	}
+	existing := map[string]int{}
+	for _, c := range target {
+		existing[client.FoldName(c.Name)] = c.ID
+	}
+
+	for _, c := range source {
+		a := Action{Kind: KindClient, Op: OpReuse, Name: c.Name, SourceID: int64(c.ID)}
+		id, ok := existing[client.FoldName(c.Name)]
+		if !ok {
+			a.Op = OpCreate
+			if !r.DryRun {
+				created, err := r.To.Clients.Create(ctx, &client.ClientData{WorkspaceID: r.ToWorkspace, Name: c.Name, Notes: c.Notes})
+				if err != nil {
+					return err
+				}
+				id = created.ID
+			}
+			existing[client.FoldName(c.Name)] = id
+		}
+		a.TargetID = int64(id)
+		r.result.Mapping.Clients[c.ID] = id
+		r.record(a)
+	}
+	return nil
+}
+
+// projectKey identifies a project of the target workspace by its client and folded name.
+// Clients not created in dry-run mode are the negated source ID.
+type projectKey struct {
+	clientID int
+	name     string
+}
+
+func (r *run) projects(ctx context.Context) error {
+	source, err := r.From.Projects.ListAll(ctx, r.FromWorkspace)
+	if err != nil {
+		return err
+	}
+	target, err := r.To.Projects.ListAll(ctx, r.ToWorkspace)
+	if err != nil {
+		return err
+	}
+	existing := map[projectKey]int{}
+	for _, p := range target {
+		existing[projectKey{p.ClientID, client.FoldName(p.Name)}] = p.ID
+	}
+
+	for _, p := range source {
+		a := Action{Kind: KindProject, Op: OpReuse, Name: p.Name, SourceID: int64(p.ID)}
+		clientID := r.result.Mapping.Clients[p.ClientID]
+		key := projectKey{clientID, client.FoldName(p.Name)}
+		if p.ClientID != 0 && clientID == 0 {
+			// The client is not created in dry-run mode: no target project can be under it
+			key.clientID = -p.ClientID
+		}
+		id, ok := existing[key]
+		if !ok {
+			a.Op = OpCreate
+			if !r.DryRun {
+				in := p
+				in.ID = 0
+				in.WorkspaceID = r.ToWorkspace
+				in.ClientID = clientID
+				created, err := r.To.Projects.Create(ctx, &in)
+				if err != nil {
+					return err
+				}
+				id = created.ID
+			}
+			existing[key] = id
+		}
+		a.TargetID = int64(id)
+		r.result.Mapping.Projects[p.ID] = id
+		r.record(a)
+	}
+	return nil
+}
+
+func (r *run) tags(ctx context.Context) error {
+	source, err := r.From.Tags.List(ctx, r.FromWorkspace)
+	if err != nil {
+		return err
+	}
+	target, err := r.To.Tags.List(ctx, r.ToWorkspace)
+	if err != nil {
+		return err
+	}
+	existing := map[string]int{}
+	for _, t := range target {
+		existing[client.FoldName(t.Name)] = t.ID
+	}
+
+	for _, t := range source {
+		a := Action{Kind: KindTag, Op: OpReuse, Name: t.Name, SourceID: int64(t.ID)}
+		id, ok := existing[client.FoldName(t.Name)]
+		if !ok {
+			a.Op = OpCreate
+			if !r.DryRun {
+				created, err := r.To.Tags.Create(ctx, &client.Tag{WorkspaceID: r.ToWorkspace, Name: t.Name})
+				if err != nil {
+					return err
+				}
+				id = created.ID
+			}
+			existing[client.FoldName(t.Name)] = id
+		}
+		a.TargetID = int64(id)
+		r.result.Mapping.Tags[t.ID] = id
+		r.record(a)
+	}
+	return nil
+}
+
+func entryKey(e *client.TimeEntry) string {
+	return e.Description + "\x00" + e.Start.UTC().Format(time.RFC3339)
+}
+
+func (r *run) timeEntries(ctx context.Context) error {
+	fromWorkspace, toWorkspace := r.FromWorkspace, r.ToWorkspace
+	if fromWorkspace == 0 {
+		fromWorkspace = r.From.DefaultWorkspace()
+	}
+	if toWorkspace == 0 {
+		toWorkspace = r.To.DefaultWorkspace()
+	}
+	source, err := r.From.TimeEntries.List(ctx, r.Since, r.Until)
+	if err != nil {
+		return err
+	}
+	target, err := r.To.TimeEntries.List(ctx, r.Since, r.Until)
+	if err != nil {
+		return err
+	}
+	existing := map[string]int64{}
+	for i := range target {
+		if target[i].WorkspaceID == toWorkspace {
+			existing[entryKey(&target[i])] = target[i].ID
+		}
+	}
+
+	for i := range source {
+		e := &source[i]
+		if e.WorkspaceID != fromWorkspace {
+			continue
+		}
+		a := Action{Kind: KindTimeEntry, Op: OpReuse, Name: e.Description, SourceID: e.ID}
+		id, ok := existing[entryKey(e)]
+		switch {
+		case ok:
+		case e.IsRunning():
+			a.Op = OpSkip
+		default:
+			a.Op = OpCreate
+			if !r.DryRun {
+				created, err := r.To.TimeEntries.Create(ctx, &client.TimeEntry{
+					WorkspaceID: toWorkspace,
+					ProjectID:   r.result.Mapping.Projects[e.ProjectID],
+					Description: e.Description,
+					Start:       e.Start,
+					Stop:        e.Stop,
+					Duration:    e.Duration,
+					Billable:    e.Billable,
+					Tags:        e.Tags,
+				})
+				if err != nil {
+					return err
+				}
+				id = created.ID
+			}
+			existing[entryKey(e)] = id
+		}
+		a.TargetID = id
+		if a.Op != OpSkip {
+			r.result.Mapping.TimeEntries[e.ID] = id
+		}
+		r.record(a)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package migrate
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+// newSource returns a source workspace with a client missing in the target, projects of the same
+// name under different clients and names differing only in case, see togglmock for the target.
+func newSource() *togglmock.Server {
+	server := togglmock.NewServer()
+	server.Handle("GET", "/api/v8/workspaces/*/clients", http.StatusOK, `[
+ {"id":1,"wid":2,"name":"Acme"},
+ {"id":2,"wid":2,"name":"Umbrella"}
+]`)
+	server.Handle("GET", "/api/v8/workspaces/*/projects", http.StatusOK, `[
+ {"id":1,"wid":2,"cid":1,"name":"Website","active":true},
+ {"id":2,"wid":2,"cid":2,"name":"Website","active":true},
+ {"id":3,"wid":2,"name":"Research","active":true},
+ {"id":4,"wid":2,"name":"research","active":true}
+]`)
+	server.Handle("GET", "/api/v8/workspaces/*/tags", http.StatusOK, `[
+ {"id":1,"wid":2,"name":"dev"},
+ {"id":2,"wid":2,"name":"Review"},
+ {"id":3,"wid":2,"name":"review"}
+]`)
+	return server
+}
+
+func TestRun(t *testing.T) {
+	for _, dryRun := range []bool{false, true} {
+		source, target := newSource(), togglmock.NewServer()
+		from, err := source.NewClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+		to, err := target.NewClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m := &Migration{From: from, FromWorkspace: 2, To: to, ToWorkspace: 1, DryRun: dryRun}
+		result, err := m.Run(context.Background())
+		source.Close()
+		target.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []struct {
+			kind, op string
+			source   int64
+		}{
+			{KindClient, OpReuse, 1}, {KindClient, OpCreate, 2},
+			{KindProject, OpReuse, 1}, {KindProject, OpCreate, 2}, {KindProject, OpCreate, 3}, {KindProject, OpReuse, 4},
+			{KindTag, OpReuse, 1}, {KindTag, OpCreate, 2}, {KindTag, OpReuse, 3},
+		}
+		if len(result.Actions) != len(want) {
+			t.Fatalf("dry run %v: actions = %+v, want %d", dryRun, result.Actions, len(want))
+		}
+		for i, w := range want {
+			if a := result.Actions[i]; a.Kind != w.kind || a.Op != w.op || a.SourceID != w.source {
+				t.Errorf("dry run %v: action %d = %s %s %d, want %s %s %d", dryRun, i, a.Kind, a.Op, a.SourceID, w.kind, w.op, w.source)
+			}
+		}
+
+		creates := map[string]int{"/api/v8/clients": 1, "/api/v8/projects": 2, "/api/v8/tags": 1}
+		for path, n := range creates {
+			if dryRun {
+				n = 0
+			}
+			if got := len(target.RequestsTo("POST", path)); got != n {
+				t.Errorf("dry run %v: POST %s sent %d times, want %d", dryRun, path, got, n)
+			}
+		}
+		if !dryRun && (result.Mapping.Projects[1] != 100 || result.Mapping.Projects[4] != result.Mapping.Projects[3]) {
+			t.Errorf("project mapping = %v, want 1 to 100 and 4 to the project created for 3", result.Mapping.Projects)
+		}
+	}
+}
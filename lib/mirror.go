@@ -0,0 +1,159 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const mirrorDayFormat = "2006-01-02"
+
+type projectDay struct {
+	projectID int
+	day       string
+}
+
+type mirrorEntry struct {
+	projectID int
+	day       string
+	duration  time.Duration
+	at        time.Time
+	deleted   bool
+}
+
+// Mirror is a local copy of time entries kept up to date by webhook events.
+// It maintains tracked time per project and day as entries change,
+// so ProjectTotals is a map lookup.
+// Entries are attributed to the day they start, in the location of the Mirror, like toggl reports do.
+// Running entries are counted once they are stopped.
+type Mirror struct {
+	location *time.Location
+
+	mu      sync.RWMutex
+	entries map[int64]*mirrorEntry
+	totals  map[projectDay]time.Duration
+}
+
+// NewMirror returns an empty Mirror attributing entries to days in location.
+func NewMirror(location *time.Location) *Mirror {
+	if location == nil {
+		location = time.UTC
+	}
+	return &Mirror{
+		location: location,
+		entries:  map[int64]*mirrorEntry{},
+		totals:   map[projectDay]time.Duration{},
+	}
+}
+
+func (m *Mirror) dayOf(t time.Time) string {
+	return t.In(m.location).Format(mirrorDayFormat)
+}
+
+// put replaces the entry unless a newer version is already mirrored. m.mu must be held.
+func (m *Mirror) put(id int64, next *mirrorEntry) {
+	if prev, ok := m.entries[id]; ok {
+		if next.at.Before(prev.at) {
+			return
+		}
+		if !prev.deleted {
+			key := projectDay{prev.projectID, prev.day}
+			m.totals[key] -= prev.duration
+			if m.totals[key] == 0 {
+				delete(m.totals, key)
+			}
+		}
+	}
+	m.entries[id] = next
+	if !next.deleted && next.duration > 0 {
+		m.totals[projectDay{next.projectID, next.day}] += next.duration
+	}
+}
+
+// Put mirrors a time entry of a webhook payload.
+func (m *Mirror) Put(e *WebhookTimeEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.put(e.ID, &mirrorEntry{
+		projectID: e.ProjectID,
+		day:       m.dayOf(e.Start),
+		duration:  e.Duration,
+		at:        e.At,
+		deleted:   e.ServerDeletedAt != nil,
+	})
+}
+
+// PutReportTimeEntry mirrors a time entry of the detailed report, e.g. to seed the Mirror.
+func (m *Mirror) PutReportTimeEntry(e *ReportTimeEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.put(e.ID, &mirrorEntry{
+		projectID: e.ProjectID,
+		day:       m.dayOf(e.Start),
+		duration:  time.Duration(e.Dur) * time.Millisecond,
+		at:        e.Updated,
+	})
+}
+
+// Delete removes the entry deleted at the given time.
+func (m *Mirror) Delete(id int64, at time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.put(id, &mirrorEntry{at: at, deleted: true})
+}
+
+// Apply updates the Mirror with a webhook event. Events of other models are ignored.
+func (m *Mirror) Apply(event *WebhookEvent) error {
+	if event.IsPing() || !event.IsTimeEntry() {
+		return nil
+	}
+	entry, err := event.TimeEntry()
+	if err != nil {
+		return err
+	}
+	if event.Metadata.Action == WebhookActionDeleted {
+		at := entry.At
+		if entry.ServerDeletedAt != nil {
+			at = *entry.ServerDeletedAt
+		}
+		m.Delete(entry.ID, at)
+		return nil
+	}
+	m.Put(entry)
+	return nil
+}
+
+// ProjectTotals returns tracked time of the project on the day.
+func (m *Mirror) ProjectTotals(projectID int, day time.Time) time.Duration {
+	key := projectDay{projectID, m.dayOf(day)}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.totals[key]
+}
+
+// Handler returns an http.Handler receiving webhook deliveries signed with secret into the Mirror.
+// Pings are answered with their validation code.
+func (m *Mirror) Handler(secret string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event, err := ParseWebhook(r, secret)
+		if err == ErrInvalidSignature {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if event.IsPing() {
+			w.Header().Set("Content-Type", contentTypeJSON)
+			json.NewEncoder(w).Encode(map[string]string{"validation_code": event.ValidationCode})
+			return
+		}
+		if err := m.Apply(event); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
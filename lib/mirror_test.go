@@ -0,0 +1,99 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func entryEvent(action string, id int64, projectID int, start string, seconds int, at string) string {
+	return fmt.Sprintf(`{"event_id":1,"metadata":{"action":%q,"model":"time_entry"},"payload":{"id":%d,"workspace_id":1,"project_id":%d,"start":%q,"duration":%d,"at":%q}}`,
+		action, id, projectID, start, seconds, at)
+}
+
+func TestMirrorTotals(t *testing.T) {
+	m := NewMirror(time.UTC)
+	june8 := time.Date(2016, 6, 8, 0, 0, 0, 0, time.UTC)
+	june9 := june8.AddDate(0, 0, 1)
+	apply := func(body string) {
+		t.Helper()
+		event := &WebhookEvent{}
+		if err := json.Unmarshal([]byte(body), event); err != nil {
+			t.Fatal(err)
+		}
+		if err := m.Apply(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	totals := func() [3]time.Duration {
+		return [3]time.Duration{m.ProjectTotals(100, june8), m.ProjectTotals(100, june9), m.ProjectTotals(101, june8)}
+	}
+
+	for _, step := range []struct {
+		name  string
+		event string
+		want  [3]time.Duration
+	}{
+		{"create", entryEvent("created", 1, 100, "2016-06-08T01:00:00Z", 3600, "2016-06-08T02:00:00Z"), [3]time.Duration{time.Hour, 0, 0}},
+		{"create another", entryEvent("created", 2, 100, "2016-06-08T03:00:00Z", 1800, "2016-06-08T03:30:00Z"), [3]time.Duration{90 * time.Minute, 0, 0}},
+		{"running", entryEvent("created", 3, 100, "2016-06-08T04:00:00Z", -1465358400, "2016-06-08T04:00:00Z"), [3]time.Duration{90 * time.Minute, 0, 0}},
+		{"move to another day", entryEvent("updated", 1, 100, "2016-06-09T01:00:00Z", 7200, "2016-06-09T03:00:00Z"), [3]time.Duration{30 * time.Minute, 2 * time.Hour, 0}},
+		{"move to another project", entryEvent("updated", 2, 101, "2016-06-08T03:00:00Z", 1800, "2016-06-08T04:00:00Z"), [3]time.Duration{0, 2 * time.Hour, 30 * time.Minute}},
+		{"stale update", entryEvent("updated", 2, 100, "2016-06-08T03:00:00Z", 600, "2016-06-08T03:45:00Z"), [3]time.Duration{0, 2 * time.Hour, 30 * time.Minute}},
+		{"delete", entryEvent("deleted", 1, 100, "2016-06-09T01:00:00Z", 7200, "2016-06-09T05:00:00Z"), [3]time.Duration{0, 0, 30 * time.Minute}},
+		// The tombstone keeps a delayed update of the deleted entry out
+		{"update after delete", entryEvent("updated", 1, 100, "2016-06-09T01:00:00Z", 3600, "2016-06-09T04:00:00Z"), [3]time.Duration{0, 0, 30 * time.Minute}},
+		{"ping", `{"event_id":2,"payload":"ping","validation_code":"abc"}`, [3]time.Duration{0, 0, 30 * time.Minute}},
+	} {
+		apply(step.event)
+		if got := totals(); got != step.want {
+			t.Errorf("%s: totals = %v, want %v", step.name, got, step.want)
+		}
+	}
+}
+
+func TestMirrorHandler(t *testing.T) {
+	const secret = "secret"
+	m := NewMirror(nil)
+	handler := m.Handler(secret)
+	deliver := func(body, signature string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("POST", "/webhook", strings.NewReader(body))
+		if signature != "" {
+			r.Header.Set(WebhookSignatureHeader, signature)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	ping := `{"event_id":1,"payload":"ping","subscription_id":7,"validation_code":"abc123"}`
+	w := deliver(ping, sign(ping, secret))
+	var answer map[string]string
+	if err := json.Unmarshal(w.Body.Bytes(), &answer); w.Code != http.StatusOK || err != nil || answer["validation_code"] != "abc123" {
+		t.Errorf("ping answered %d %q, want the validation code", w.Code, w.Body)
+	}
+
+	created := entryEvent("created", 1, 100, "2016-06-08T01:00:00Z", 3600, "2016-06-08T02:00:00Z")
+	if w := deliver(created, ""); w.Code != http.StatusUnauthorized {
+		t.Errorf("unsigned delivery answered %d, want 401", w.Code)
+	}
+	if w := deliver(created, sign(created, "other")); w.Code != http.StatusUnauthorized {
+		t.Errorf("delivery signed with another secret answered %d, want 401", w.Code)
+	}
+	if got := m.ProjectTotals(100, time.Date(2016, 6, 8, 0, 0, 0, 0, time.UTC)); got != 0 {
+		t.Errorf("totals after rejected deliveries = %v, want 0", got)
+	}
+	if w := deliver("{", sign("{", secret)); w.Code != http.StatusBadRequest {
+		t.Errorf("invalid delivery answered %d, want 400", w.Code)
+	}
+	if w := deliver(created, sign(created, secret)); w.Code != http.StatusOK {
+		t.Errorf("delivery answered %d, want 200", w.Code)
+	}
+	if got := m.ProjectTotals(100, time.Date(2016, 6, 8, 0, 0, 0, 0, time.UTC)); got != time.Hour {
+		t.Errorf("totals after the delivery = %v, want 1h", got)
+	}
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"strings"
+	"unicode"
+)
+
+// decompositions is the reverse of compositions, used to strip marks when transliterating.
+var decompositions = func() map[rune][2]rune {
+	m := make(map[rune][2]rune, len(compositions))
+	for pair, composed := range compositions {
+		m[composed] = pair
+	}
+	return m
+}()
+
+// singletons are characters whose canonical decomposition is a single character,
+// e.g. ANGSTROM SIGN and the Greek letters with oxia, which NFC replaces by that character.
+var singletons = map[rune]rune{
+	'\u212a': 'K', '\u212b': '\u00c5', '\u2126': '\u03a9',
+	'\u0374': '\u02b9', '\u037e': ';', '\u0387': '\u00b7',
+	'\u1f71': '\u03ac', '\u1f73': '\u03ad', '\u1f75': '\u03ae', '\u1f77': '\u03af',
+	'\u1f79': '\u03cc', '\u1f7b': '\u03cd', '\u1f7d': '\u03ce', '\u1fbb': '\u0386',
+	'\u1fbe': '\u03b9', '\u1fc9': '\u0388', '\u1fcb': '\u0389', '\u1fd3': '\u0390',
+	'\u1fdb': '\u038a', '\u1fe3': '\u03b0', '\u1feb': '\u038e', '\u1fee': '\u0385',
+	'\u1fef': '`', '\u1ff9': '\u038c', '\u1ffb': '\u038f', '\u1ffd': '\u00b4',
+}
+
+// latinLetters are transliterations of Latin letters which have no decomposition.
+var latinLetters = map[rune]string{
+	'ß': "ss", 'ẞ': "SS",
+	'æ': "ae", 'Æ': "AE",
+	'œ': "oe", 'Œ': "OE",
+	'ø': "o", 'Ø': "O",
+	'ł': "l", 'Ł': "L",
+	'đ': "d", 'Đ': "D",
+	'ð': "d", 'Ð': "D",
+	'þ': "th", 'Þ': "TH",
+	'ı': "i",
+}
+
+func isZeroWidth(r rune) bool {
+	switch r {
+	case '\u200b', '\u200c', '\u200d', '\u2060', '\ufeff', '\u00ad':
+		return true
+	}
+	return false
+}
+
+// NormalizeName cleans up a project name or entry description before it is created or searched.
+// Zero-width characters are removed, white spaces are collapsed into a single space and trimmed,
+// singleton characters are replaced by their canonical equivalents, and base characters followed
+// by combining marks are composed into the precomposed form for Latin, Greek, Cyrillic and Kana.
+// This matches NFC for these scripts as long as multiple marks come in canonical order;
+// marks are not reordered and other scripts are left as they are.
+func NormalizeName(s string) string {
+	runes := make([]rune, 0, len(s))
+	space := false
+	for _, r := range s {
+		if isZeroWidth(r) {
+			continue
+		}
+		if unicode.IsSpace(r) {
+			space = len(runes) > 0
+			continue
+		}
+		if space {
+			runes = append(runes, ' ')
+			space = false
+		}
+		if single, ok := singletons[r]; ok {
+			r = single
+		}
+		if n := len(runes); n > 0 {
+			if composed, ok := compositions[[2]rune{runes[n-1], r}]; ok {
+				runes[n-1] = composed
+				continue
+			}
+		}
+		runes = append(runes, r)
+	}
+	return string(runes)
+}
+
+// TransliterateName normalizes the name and folds accented Latin letters into ASCII,
+// e.g. "Café Ærø" becomes "Cafe AEro". Other scripts are left as they are.
+func TransliterateName(s string) string {
+	return stripMarks(NormalizeName(s), false)
+}
+
+func isLatin(r rune) bool {
+	return r >= 0x80 && r < 0x250 || r >= 0x1e00 && r < 0x1f00
+}
+
+func isGreek(r rune) bool {
+	return r >= 0x370 && r < 0x400 || r >= 0x1f00 && r < 0x2000
+}
+
+// stripMarks transliterates Latin letters of the normalized s and drops their combining marks,
+// and those of Greek letters too when greek is set.
+func stripMarks(s string, greek bool) string {
+	var b strings.Builder
+	for _, r := range s {
+		if ascii, ok := latinLetters[r]; ok {
+			b.WriteString(ascii)
+			continue
+		}
+		for isLatin(r) || greek && isGreek(r) {
+			pair, ok := decompositions[r]
+			if !ok {
+				break
+			}
+			r = pair[0]
+		}
+		if unicode.Is(unicode.Mn, r) && r < 0x370 {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// FoldName returns the key names are compared with for deduplication and search.
+// Names which differ only in case, accents of Latin and Greek letters, white spaces
+// or invisible characters have the same key, e.g. "Ἀθῆναι" and "ΑΘΗΝΑΙ".
+func FoldName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == 'ς' {
+			return 'σ'
+		}
+		return r
+	}, strings.ToLower(stripMarks(NormalizeName(s), true)))
+}
+
+// EqualNames reports whether a and b are the same name after folding.
+func EqualNames(a, b string) bool {
+	return FoldName(a) == FoldName(b)
+}
@@ -0,0 +1,66 @@
+package client
+
+import "testing"
+
+func TestNormalizeName(t *testing.T) {
+	for _, tc := range []struct {
+		name, in, want string
+	}{
+		{"latin combining", "Cafe\u0301", "Café"},
+		{"greek combining", "α\u0301", "ά"},
+		{"greek stacked", "α\u0313\u0301", "ἄ"},
+		{"angstrom sign", "\u212b", "Å"},
+		{"kelvin sign", "\u212a", "K"},
+		{"greek oxia", "\u1f71", "ά"},
+		{"cyrillic", "И\u0306", "Й"},
+		{"zero width", "We\u200bb\u00adsite\ufeff", "Website"},
+		{"white spaces", " \tWeb  \n site  ", "Web site"},
+		{"unchanged", "Support", "Support"},
+	} {
+		if got := NormalizeName(tc.in); got != tc.want {
+			t.Errorf("%s: NormalizeName(%q) = %q, want %q", tc.name, tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestTransliterateName(t *testing.T) {
+	for _, tc := range []struct {
+		in, want string
+	}{
+		{"Café Ærø", "Cafe AEro"},
+		{"Straße", "Strasse"},
+		{"Łódź", "Lodz"},
+		{"\u212bngström A\u030angstro\u0308m", "Angstrom Angstrom"},
+		// Greek is not transliterated
+		{"Ἀθῆναι", "Ἀθῆναι"},
+	} {
+		if got := TransliterateName(tc.in); got != tc.want {
+			t.Errorf("TransliterateName(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestFoldName(t *testing.T) {
+	for _, tc := range []struct {
+		name, a, b string
+		equal      bool
+	}{
+		{"case", "Website", "WEBSITE", true},
+		{"latin accents", "Café", "cafe", true},
+		{"latin combining", "Cafe\u0301", "Café", true},
+		{"greek tonos", "Αθήνα", "αθηνα", true},
+		{"greek dialytika", "Ϊ", "ι", true},
+		{"greek polytonic", "Ἀθῆναι", "ΑΘΗΝΑΙ", true},
+		{"greek final sigma", "ΣΟΦΟΣ", "σοφος", true},
+		{"singleton", "\u212bngström", "angstrom", true},
+		{"invisible", "Web\u200dsite", "website", true},
+		{"white spaces", "  Web\t\tsite ", "web site", true},
+		{"different words", "Website", "Support", false},
+		{"different letters", "Website", "Websites", false},
+		{"cyrillic letter", "Й", "И", false},
+	} {
+		if got := EqualNames(tc.a, tc.b); got != tc.equal {
+			t.Errorf("%s: EqualNames(%q, %q) = %v (%q, %q), want %v", tc.name, tc.a, tc.b, got, FoldName(tc.a), FoldName(tc.b), tc.equal)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Option configures a Client in NewClient
+type Option func(*Client) error
+
+// WithValidateCredentials makes NewClient check the API token against toggl
+// and return ErrUnauthorized when it is rejected.
+func WithValidateCredentials() Option {
+	return func(c *Client) error {
+		c.validateCredentials = true
+		return nil
+	}
+}
+
+// WithHTTPClient makes the client send requests with the given http.Client.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithBaseURL sends every request to the given scheme and host instead of toggl.
+// A path in baseURL is prefixed to the API paths. It is meant for proxies and fake servers.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.baseURL = u
+		return nil
+	}
+}
+
+// WithAcceptLanguage asks toggl for error messages in the given languages,
+// as an Accept-Language header value such as "ja" or "de-DE, en;q=0.5".
+func WithAcceptLanguage(languages string) Option {
+	return func(c *Client) error {
+		c.acceptLanguage = languages
+		return nil
+	}
+}
+
+// rebase replaces scheme and host of u with the ones of base.
+func rebase(u, base *url.URL) {
+	u.Scheme = base.Scheme
+	u.Host = base.Host
+	if prefix := strings.TrimSuffix(base.Path, "/"); prefix != "" {
+		u.Path = prefix + u.Path
+	}
+}
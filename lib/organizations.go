@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Organization represent a toggl organization, which owns workspaces
+type Organization struct {
+	ID              int        `json:"id"`
+	Name            string     `json:"name"`
+	PricingPlanID   int        `json:"pricing_plan_id,omitempty"`
+	Admin           bool       `json:"admin"`
+	Owner           bool       `json:"owner"`
+	MaxWorkspaces   int        `json:"max_workspaces,omitempty"`
+	UserCount       int        `json:"user_count,omitempty"`
+	At              time.Time  `json:"at"`
+	ServerDeletedAt *time.Time `json:"server_deleted_at,omitempty"`
+}
+
+// OrganizationUser is a member of an organization
+type OrganizationUser struct {
+	ID         int    `json:"id"`
+	UserID     int    `json:"user_id"`
+	Name       string `json:"name"`
+	Email      string `json:"email"`
+	Admin      bool   `json:"admin"`
+	Owner      bool   `json:"owner"`
+	Inactive   bool   `json:"inactive"`
+	Joined     bool   `json:"joined"`
+	Workspaces []struct {
+		WorkspaceID int  `json:"workspace_id"`
+		Admin       bool `json:"admin"`
+	} `json:"workspaces,omitempty"`
+}
+
+// OrganizationGroupUser is a member of an organization group
+type OrganizationGroupUser struct {
+	UserID int    `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+// OrganizationGroup is a group of users in an organization
+type OrganizationGroup struct {
+	ID         int                     `json:"group_id"`
+	Name       string                  `json:"name"`
+	Workspaces []int                   `json:"workspaces"`
+	Users      []OrganizationGroupUser `json:"users"`
+	At         time.Time               `json:"at"`
+}
+
+// WorkspaceParams are the settings given to create or update a workspace.
+// Nil fields are left unchanged.
+type WorkspaceParams struct {
+	Name                        string   `json:"name,omitempty"`
+	Rounding                    *int     `json:"rounding,omitempty"`
+	RoundingMinutes             *int     `json:"rounding_minutes,omitempty"`
+	DefaultHourlyRate           *float64 `json:"default_hourly_rate,omitempty"`
+	DefaultCurrency             string   `json:"default_currency,omitempty"`
+	OnlyAdminsMayCreateProjects *bool    `json:"only_admins_may_create_projects,omitempty"`
+	OnlyAdminsSeeBillableRates  *bool    `json:"only_admins_see_billable_rates,omitempty"`
+	OnlyAdminsSeeTeamDashboard  *bool    `json:"only_admins_see_team_dashboard,omitempty"`
+}
+
+// OrganizationsService handles organization endpoints of the API v9
+type OrganizationsService struct {
+	client *Client
+}
+
+// List returns organizations the owner of the API token belongs to.
+func (s *OrganizationsService) List(ctx context.Context) ([]Organization, error) {
+	var organizations []Organization
+	err := s.client.get(ctx, endpointV9+"/me/organizations", &organizations)
+	return organizations, err
+}
+
+// Get returns the organization.
+func (s *OrganizationsService) Get(ctx context.Context, organizationID int) (*Organization, error) {
+	organization := &Organization{}
+	err := s.client.get(ctx, fmt.Sprintf("%s/organizations/%d", endpointV9, organizationID), organization)
+	if err != nil {
+		return nil, err
+	}
+	return organization, nil
+}
+
+// Users returns users of the organization.
+func (s *OrganizationsService) Users(ctx context.Context, organizationID int) ([]OrganizationUser, error) {
+	var users []OrganizationUser
+	err := s.client.get(ctx, fmt.Sprintf("%s/organizations/%d/users", endpointV9, organizationID), &users)
+	return users, err
+}
+
+// Groups returns groups of the organization.
+func (s *OrganizationsService) Groups(ctx context.Context, organizationID int) ([]OrganizationGroup, error) {
+	var groups []OrganizationGroup
+	err := s.client.get(ctx, fmt.Sprintf("%s/organizations/%d/groups", endpointV9, organizationID), &groups)
+	return groups, err
+}
+
+// CreateWorkspace creates a workspace in the organization.
+func (s *OrganizationsService) CreateWorkspace(ctx context.Context, organizationID int, params *WorkspaceParams) (*Workspace, error) {
+	if params.Name == "" {
+		return nil, fmt.Errorf("workspace name is required.\n")
+	}
+	workspace := &Workspace{}
+	err := s.client.do(ctx, "POST", fmt.Sprintf("%s/organizations/%d/workspaces", endpointV9, organizationID), params, workspace)
+	if err != nil {
+		return nil, err
+	}
+	s.client.invalidateCachedWorkspaces()
+	return workspace, nil
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Cursor is the position of a paginated operation, to resume it after a failure
+type Cursor struct {
+	// Page is the page of the reports API v2
+	Page int
+	// FirstID and FirstRowNumber are the page of the reports API v3
+	FirstID        int64
+	FirstRowNumber int
+	// Offset is the number of rows of the page already delivered
+	Offset int
+}
+
+// PartialResultError is returned by paginated operations which stopped part way,
+// when the context is canceled or a page fails. Rows before Cursor are delivered,
+// so giving Cursor to the Resume method continues exactly where it stopped.
+type PartialResultError struct {
+	Cursor Cursor
+	// Delivered is the number of rows delivered by the call
+	Delivered int
+	Err       error
+}
+
+func (e *PartialResultError) Error() string {
+	return fmt.Sprintf("stopped after %d rows: %v", e.Delivered, e.Err)
+}
+
+// Unwrap returns the cause, e.g. context.Canceled.
+func (e *PartialResultError) Unwrap() error {
+	return e.Err
+}
+
+// EachDetailed calls fn with every time entry of the detailed report, from params.Page to the last page.
+// Pages are streamed like StreamDetailed, so the entry given to fn is reused once fn returns.
+// It stops at the first error of fn, which is returned as is, unless it is the error of ctx:
+// then the entry is not delivered and a *PartialResultError is returned.
+func (s *ReportsService) EachDetailed(ctx context.Context, params *ReportParams, fn func(*ReportTimeEntry) error) error {
+	page := params.Page
+	if page < 1 {
+		page = 1
+	}
+	return s.ResumeDetailed(ctx, params, Cursor{Page: page}, fn)
+}
+
+// ResumeDetailed is EachDetailed starting from cursor.
+func (s *ReportsService) ResumeDetailed(ctx context.Context, params *ReportParams, cursor Cursor, fn func(*ReportTimeEntry) error) error {
+	delivered := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return &PartialResultError{Cursor: cursor, Delivered: delivered, Err: err}
+		}
+		p := *params
+		p.Page = cursor.Page
+		rows, skip := 0, cursor.Offset
+		var fnErr error
+		report, err := s.StreamDetailed(ctx, &p, func(e *ReportTimeEntry) error {
+			rows++
+			if rows <= skip {
+				return nil
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if fnErr = fn(e); fnErr != nil {
+				return fnErr
+			}
+			cursor.Offset = rows
+			delivered++
+			return nil
+		})
+		if fnErr != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(fnErr, ctxErr) {
+				return &PartialResultError{Cursor: cursor, Delivered: delivered, Err: fnErr}
+			}
+			return fnErr
+		}
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				err = ctxErr
+			}
+			return &PartialResultError{Cursor: cursor, Delivered: delivered, Err: err}
+		}
+
+		if rows == 0 || report.PerPage == 0 || cursor.Page*report.PerPage >= report.TotalCount {
+			return nil
+		}
+		cursor = Cursor{Page: cursor.Page + 1}
+	}
+}
+
+// EachTimeEntries calls fn with every row of the detailed search, from the page of params to the last page.
+// It stops at the first error of fn like EachDetailed.
+func (s *ReportsV3Service) EachTimeEntries(ctx context.Context, workspaceID int, params *ReportsV3SearchParams, fn func(*ReportsV3Row) error) error {
+	return s.ResumeTimeEntries(ctx, workspaceID, params, Cursor{
+		FirstID:        params.FirstID,
+		FirstRowNumber: params.FirstRowNumber,
+	}, fn)
+}
+
+// ResumeTimeEntries is EachTimeEntries starting from cursor.
+func (s *ReportsV3Service) ResumeTimeEntries(ctx context.Context, workspaceID int, params *ReportsV3SearchParams, cursor Cursor, fn func(*ReportsV3Row) error) error {
+	delivered := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return &PartialResultError{Cursor: cursor, Delivered: delivered, Err: err}
+		}
+		p := *params
+		p.FirstID = cursor.FirstID
+		p.FirstRowNumber = cursor.FirstRowNumber
+		page, err := s.SearchTimeEntries(ctx, workspaceID, &p)
+		if err != nil {
+			return &PartialResultError{Cursor: cursor, Delivered: delivered, Err: err}
+		}
+
+		for i := cursor.Offset; i < len(page.Rows); i++ {
+			if err := ctx.Err(); err != nil {
+				return &PartialResultError{Cursor: cursor, Delivered: delivered, Err: err}
+			}
+			if err := fn(&page.Rows[i]); err != nil {
+				if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+					return &PartialResultError{Cursor: cursor, Delivered: delivered, Err: err}
+				}
+				return err
+			}
+			cursor.Offset = i + 1
+			delivered++
+		}
+
+		if page.NextRowNumber == 0 {
+			return nil
+		}
+		cursor = Cursor{FirstID: page.NextID, FirstRowNumber: page.NextRowNumber}
+	}
+}
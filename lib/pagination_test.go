@@ -0,0 +1,48 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestDetailedEntriesCancel(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := &client.ReportParams{}
+
+	// togglmock.FixtureReportDetailed has two entries on a single page
+	ctx, cancel := context.WithCancel(context.Background())
+	entries, errc := c.Reports.DetailedEntries(ctx, params)
+	first := <-entries
+	cancel()
+	for range entries {
+		t.Error("an entry was sent after cancellation")
+	}
+	var perr *client.PartialResultError
+	if err := <-errc; !errors.As(err, &perr) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want a *PartialResultError of the cancellation", err)
+	}
+	if perr.Delivered != 1 || perr.Cursor != (client.Cursor{Page: 1, Offset: 1}) {
+		t.Errorf("partial result = %d rows at %+v, want 1 row at page 1 offset 1", perr.Delivered, perr.Cursor)
+	}
+
+	var rest []client.ReportTimeEntry
+	err = c.Reports.ResumeDetailed(context.Background(), params, perr.Cursor, func(e *client.ReportTimeEntry) error {
+		rest = append(rest, *e)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest) != 1 || rest[0].ID == first.ID {
+		t.Errorf("resumed entries = %+v, want the second entry only", rest)
+	}
+}
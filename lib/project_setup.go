@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Task is a task of a project, a premium feature
+type Task struct {
+	ID          int    `json:"id,omitempty"`
+	WorkspaceID int    `json:"wid,omitempty"`
+	ProjectID   int    `json:"pid"`
+	UserID      int    `json:"uid,omitempty"`
+	Name        string `json:"name"`
+	// EstimatedSeconds is the estimate of the task
+	EstimatedSeconds int64     `json:"estimated_seconds,omitempty"`
+	Active           bool      `json:"active"`
+	At               time.Time `json:"at,omitzero"`
+}
+
+// ProjectUser is a member of a project, with the hourly rate billed for its time
+type ProjectUser struct {
+	ID          int       `json:"id,omitempty"`
+	WorkspaceID int       `json:"wid,omitempty"`
+	ProjectID   int       `json:"pid"`
+	UserID      int       `json:"uid"`
+	Manager     bool      `json:"manager"`
+	Rate        float64   `json:"rate,omitempty"`
+	At          time.Time `json:"at,omitzero"`
+}
+
+// TaskSpec is a task to create with a project
+type TaskSpec struct {
+	Name     string
+	Estimate time.Duration
+	// UserID is the user the task is assigned to, 0 for none
+	UserID int
+}
+
+// ProjectUserSpec is a user to add to a project
+type ProjectUserSpec struct {
+	UserID  int
+	Manager bool
+	// Rate is the hourly rate of the user on the project, 0 for the default rate
+	Rate float64
+}
+
+// ProjectSpec is a project to create with its tasks and members.
+// Project.EstimatedHours sets the estimate of the project.
+// Project.Active is ignored: the project is created active like its tasks, unless Archived is set.
+type ProjectSpec struct {
+	Project  Project
+	Archived bool
+	Tasks    []TaskSpec
+	Users    []ProjectUserSpec
+}
+
+// CompleteProject is a project created by CreateComplete
+type CompleteProject struct {
+	Project *Project
+	Tasks   []Task
+	Users   []ProjectUser
+}
+
+// CreateProjectError is returned by CreateComplete when a step failed.
+// The project was deleted again unless RollbackErr is set.
+type CreateProjectError struct {
+	// Step is "project", "task" or "user"
+	Step        string
+	Err         error
+	RollbackErr error
+}
+
+func (e *CreateProjectError) Error() string {
+	if e.RollbackErr != nil {
+		return fmt.Sprintf("creating %s: %v, and deleting the project failed: %v", e.Step, e.Err, e.RollbackErr)
+	}
+	return fmt.Sprintf("creating %s: %v", e.Step, e.Err)
+}
+
+// Unwrap returns the error of the failed step.
+func (e *CreateProjectError) Unwrap() error {
+	return e.Err
+}
+
+// rollbackTimeout bounds deleting the project after a failed step of CreateComplete
+const rollbackTimeout = 30 * time.Second
+
+// Delete deletes the project with its tasks.
+func (s *ProjectsService) Delete(ctx context.Context, workspaceID, id int) error {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return err
+	}
+	err = s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", endpointProjects, id), nil, nil)
+	if err != nil {
+		return err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return nil
+}
+
+// CreateComplete creates the project, its tasks and its members with their rates in one go.
+// When a step fails, the project is deleted with what was created for it, and a *CreateProjectError is returned.
+// The project is deleted even when ctx is done, within rollbackTimeout.
+func (s *ProjectsService) CreateComplete(ctx context.Context, spec *ProjectSpec) (*CompleteProject, error) {
+	in := spec.Project
+	in.Active = !spec.Archived
+	project, err := s.Create(ctx, &in)
+	if err == nil && project == nil {
+		err = ErrNoData
+	}
+	if err != nil {
+		return nil, &CreateProjectError{Step: "project", Err: err}
+	}
+	result := &CompleteProject{Project: project}
+	fail := func(step string, err error) (*CompleteProject, error) {
+		rctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
+		defer cancel()
+		return nil, &CreateProjectError{Step: step, Err: err, RollbackErr: s.Delete(rctx, project.WorkspaceID, project.ID)}
+	}
+
+	for _, t := range spec.Tasks {
+		body := struct {
+			Data *Task `json:"data"`
+		}{}
+		task := &Task{
+			WorkspaceID:      project.WorkspaceID,
+			ProjectID:        project.ID,
+			UserID:           t.UserID,
+			Name:             t.Name,
+			EstimatedSeconds: int64(t.Estimate / time.Second),
+			Active:           true,
+		}
+		err := s.client.do(ctx, "POST", endpointTasks, struct {
+			Task *Task `json:"task"`
+		}{task}, &body)
+		if err == nil && body.Data == nil {
+			err = ErrNoData
+		}
+		if err != nil {
+			return fail("task", err)
+		}
+		result.Tasks = append(result.Tasks, *body.Data)
+	}
+
+	for _, u := range spec.Users {
+		body := struct {
+			Data *ProjectUser `json:"data"`
+		}{}
+		user := &ProjectUser{
+			WorkspaceID: project.WorkspaceID,
+			ProjectID:   project.ID,
+			UserID:      u.UserID,
+			Manager:     u.Manager,
+			Rate:        u.Rate,
+		}
+		err := s.client.do(ctx, "POST", endpointProjectUsers, struct {
+			ProjectUser *ProjectUser `json:"project_user"`
+		}{user}, &body)
+		if err == nil && body.Data == nil {
+			err = ErrNoData
+		}
+		if err != nil {
+			return fail("user", err)
+		}
+		result.Users = append(result.Users, *body.Data)
+	}
+	return result, nil
+}
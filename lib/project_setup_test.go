@@ -0,0 +1,111 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+// cancelOn cancels the context of the call when a request for method and path is sent.
+type cancelOn struct {
+	method, path string
+	cancel       context.CancelFunc
+}
+
+func (t *cancelOn) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == t.method && req.URL.Path == t.path {
+		t.cancel()
+		return nil, req.Context().Err()
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestCreateCompleteRollback(t *testing.T) {
+	spec := &client.ProjectSpec{
+		Project: client.Project{Name: "Research"},
+		Tasks:   []client.TaskSpec{{Name: "Kickoff", Estimate: 2 * time.Hour}},
+	}
+
+	t.Run("no data", func(t *testing.T) {
+		server := togglmock.NewServer()
+		defer server.Close()
+		server.Handle("POST", "/api/v8/tasks", http.StatusOK, `{}`)
+		c, err := server.NewClient(client.WithDefaultWorkspace(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = c.Projects.CreateComplete(context.Background(), spec)
+		var perr *client.CreateProjectError
+		if !errors.As(err, &perr) || perr.Step != "task" || !errors.Is(err, client.ErrNoData) || perr.RollbackErr != nil {
+			t.Fatalf("CreateComplete error = %v, want ErrNoData creating the task", err)
+		}
+		if got := len(server.RequestsTo("DELETE", "/api/v8/projects/102")); got != 1 {
+			t.Errorf("project deleted %d times, want 1", got)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		server := togglmock.NewServer()
+		defer server.Close()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		transport := &cancelOn{method: "POST", path: "/api/v8/tasks", cancel: cancel}
+		c, err := server.NewClient(client.WithDefaultWorkspace(1), client.WithHTTPClient(&http.Client{Transport: transport}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = c.Projects.CreateComplete(ctx, spec)
+		var perr *client.CreateProjectError
+		if !errors.As(err, &perr) || perr.Step != "task" || perr.RollbackErr != nil {
+			t.Fatalf("CreateComplete error = %v, want a failed task step rolled back", err)
+		}
+		if got := len(server.RequestsTo("DELETE", "/api/v8/projects/102")); got != 1 {
+			t.Errorf("project deleted %d times after cancellation, want 1", got)
+		}
+	})
+}
+
+func TestCreateCompleteActive(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		spec   client.ProjectSpec
+		active bool
+	}{
+		{"default", client.ProjectSpec{Project: client.Project{Name: "Research"}}, true},
+		{"archived", client.ProjectSpec{Project: client.Project{Name: "Research", Active: true}, Archived: true}, false},
+	} {
+		server := togglmock.NewServer()
+		c, err := server.NewClient(client.WithDefaultWorkspace(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Projects.CreateComplete(context.Background(), &tc.spec); err != nil {
+			t.Fatal(err)
+		}
+		requests := server.RequestsTo("POST", "/api/v8/projects")
+		if len(requests) != 1 {
+			t.Fatalf("%s: %d projects created, want 1", tc.name, len(requests))
+		}
+		var body struct {
+			Project map[string]interface{} `json:"project"`
+		}
+		if err := json.Unmarshal(requests[0].Body, &body); err != nil {
+			t.Fatal(err)
+		}
+		if body.Project["active"] != tc.active {
+			t.Errorf("%s: created project active = %v, want %v", tc.name, body.Project["active"], tc.active)
+		}
+		if _, ok := body.Project["at"]; ok {
+			t.Errorf("%s: created project has at %v, want none", tc.name, body.Project["at"])
+		}
+		server.Close()
+	}
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Project represent a toggl project
+type Project struct {
+	ID          int    `json:"id"`
+	WorkspaceID int    `json:"wid"`
+	ClientID    int    `json:"cid,omitempty"`
+	Name        string `json:"name"`
+	Billable    bool   `json:"billable"`
+	IsPrivate   bool   `json:"is_private"`
+	Active      bool   `json:"active"`
+	Color       string `json:"color,omitempty"`
+	// EstimatedHours is the budget of the project, a premium feature
+	EstimatedHours int       `json:"estimated_hours,omitempty"`
+	At             time.Time `json:"at,omitzero"`
+}
+
+// ProjectsService handles project endpoints
+type ProjectsService struct {
+	client *Client
+}
+
+// List returns projects of the workspace.
+// Results are cached as described by WithLookupCache.
+func (s *ProjectsService) List(ctx context.Context, workspaceID int) ([]Project, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if projects, ok := s.client.lookups.projects(workspaceID); ok && callSettingsFrom(ctx).validators == nil {
+		return projects, nil
+	}
+
+	var projects []Project
+	err = s.client.getCached(ctx, fmt.Sprintf("%s/%d/projects", endpointWorkspaces, workspaceID), &projects)
+	if err != nil {
+		return nil, err
+	}
+	s.client.lookups.setProjects(workspaceID, projects)
+	return projects, nil
+}
+
+// ListAll returns active and archived projects of the workspace.
+func (s *ProjectsService) ListAll(ctx context.Context, workspaceID int) ([]Project, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	var projects []Project
+	err = s.client.getCached(ctx, fmt.Sprintf("%s/%d/projects?active=both", endpointWorkspaces, workspaceID), &projects)
+	if err != nil {
+		return nil, err
+	}
+	s.client.lookups.observe(workspaceID, projectAts(projects)...)
+	return projects, nil
+}
+
+// Create creates the project in its workspace, the default workspace when WorkspaceID is 0.
+func (s *ProjectsService) Create(ctx context.Context, p *Project) (*Project, error) {
+	in := *p
+	workspaceID, err := s.client.workspace(in.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	in.WorkspaceID = workspaceID
+	body := struct {
+		Data *Project `json:"data"`
+	}{}
+	err = s.client.do(ctx, "POST", endpointProjects, struct {
+		Project *Project `json:"project"`
+	}{&in}, &body)
+	if err != nil {
+		return nil, err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return body.Data, nil
+}
+
+// Update updates the project, which must have an ID. Its workspace is the default workspace when WorkspaceID is 0.
+func (s *ProjectsService) Update(ctx context.Context, p *Project) (*Project, error) {
+	if p.ID == 0 {
+		return nil, ErrIdUnset
+	}
+	in := *p
+	workspaceID, err := s.client.workspace(in.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	in.WorkspaceID = workspaceID
+	body := struct {
+		Data *Project `json:"data"`
+	}{}
+	err = s.client.do(ctx, "PUT", fmt.Sprintf("%s/%d", endpointProjects, in.ID), struct {
+		Project *Project `json:"project"`
+	}{&in}, &body)
+	if err != nil {
+		return nil, err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return body.Data, nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultRateInterval is the spacing of requests toggl asks API clients for
+const DefaultRateInterval = time.Second
+
+// WithRateLimit spaces the requests of the client by at least interval, DefaultRateInterval when 0.
+// A 429 response delays the next requests by its Retry-After.
+func WithRateLimit(interval time.Duration) Option {
+	return func(c *Client) error {
+		if interval == 0 {
+			interval = DefaultRateInterval
+		}
+		c.limiter = &rateLimiter{interval: interval}
+		return nil
+	}
+}
+
+// rateLimiter hands out the times requests may be sent at, interval apart.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// wait blocks until the next request may be sent, or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	d := at.Sub(now)
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// delay holds the next requests back for d.
+func (l *rateLimiter) delay(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if at := time.Now().Add(d); at.After(l.next) {
+		l.next = at
+	}
+}
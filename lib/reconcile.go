@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// implicitStopWindow is how close the stop of the entry running before a start must be
+// to the start of the new one to be taken as stopped by it.
+const implicitStopWindow = time.Second
+
+// ImplicitStopEvent reports the running entry toggl stopped when another one was started,
+// e.g. by an other device racing to start its own timer.
+type ImplicitStopEvent struct {
+	Stopped *TimeEntry
+	Started *TimeEntry
+	// Restored is true when Stopped runs again and Started was deleted
+	Restored bool
+}
+
+func (e *ImplicitStopEvent) eventName() string { return "implicit_stop" }
+
+// StartConflictError is returned by Start when the entry stopped implicitly was restored.
+// Running is the restored entry, and the entry given to Start was not kept.
+type StartConflictError struct {
+	Running *TimeEntry
+}
+
+func (e *StartConflictError) Error() string {
+	return fmt.Sprintf("time entry %d %q is running, the new entry was not started", e.Running.ID, e.Running.Description)
+}
+
+// WithReconciliation makes Start look for the running entry toggl stopped implicitly, and emit
+// an *ImplicitStopEvent when it finds one. When restore is not nil and returns true for the event,
+// the new entry is deleted, the stopped one runs again and Start returns a *StartConflictError.
+// Reconciliation gets the running entry before every start, and gets it again after the start
+// to see whether toggl stopped it then. Entries stopped before, e.g. by Stop, are never candidates.
+func WithReconciliation(restore func(*ImplicitStopEvent) bool) Option {
+	return func(c *Client) error {
+		c.reconcile = true
+		c.restoreStopped = restore
+		return nil
+	}
+}
+
+// reconcile checks whether running, the entry running before started, was stopped by starting it.
+// On failure, the started entry is returned with the error.
+func (s *TimeEntriesService) reconcile(ctx context.Context, running, started *TimeEntry) (*TimeEntry, error) {
+	if running.ID == started.ID {
+		return started, nil
+	}
+	stopped, err := s.Get(ctx, running.ID)
+	if err != nil {
+		return started, err
+	}
+	if stopped == nil || stopped.Stop.IsZero() {
+		return started, nil
+	}
+	if d := stopped.Stop.Sub(started.Start); d < -implicitStopWindow || d > implicitStopWindow {
+		return started, nil
+	}
+
+	event := &ImplicitStopEvent{Stopped: stopped, Started: started}
+	if s.client.restoreStopped == nil || !s.client.restoreStopped(event) {
+		s.client.emit(event)
+		return started, nil
+	}
+	if err := s.Delete(ctx, started.ID); err != nil {
+		s.client.emit(event)
+		return started, err
+	}
+	restore := *stopped
+	restore.Stop = time.Time{}
+	restore.Duration = 0
+	restored, err := s.Update(ctx, &restore)
+	if err != nil {
+		s.client.emit(event)
+		return nil, err
+	}
+	event.Stopped = restored
+	event.Restored = true
+	s.client.emit(event)
+	return nil, &StartConflictError{Running: restored}
+}
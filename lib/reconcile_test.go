@@ -0,0 +1,82 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+// runningBefore is the entry another device started before the start of togglmock.FixtureTimeEntry at 01:00
+const runningBefore = `{"data":{"id":4000,"wid":1,"start":"2016-06-09T00:00:00+00:00","duration":-1465430400,"description":"Other device"}}`
+
+func TestReconcile(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		// current is the entry running before the start, after it is the same entry after the start
+		current, after string
+		restore        bool
+		stopped        bool
+	}{
+		{"stopped by the start", runningBefore,
+			`{"data":{"id":4000,"wid":1,"start":"2016-06-09T00:00:00+00:00","stop":"2016-06-09T01:00:00+00:00","duration":3600,"description":"Other device"}}`,
+			false, true},
+		{"restored", runningBefore,
+			`{"data":{"id":4000,"wid":1,"start":"2016-06-09T00:00:00+00:00","stop":"2016-06-09T01:00:00+00:00","duration":3600,"description":"Other device"}}`,
+			true, true},
+		// The user stopped an entry at 01:00 and then started the new one, nothing ran in between
+		{"stopped before", `{"data":null}`, "", true, false},
+		{"stopped long before the start", runningBefore,
+			`{"data":{"id":4000,"wid":1,"start":"2016-06-09T00:00:00+00:00","stop":"2016-06-09T00:30:00+00:00","duration":1800,"description":"Other device"}}`,
+			true, false},
+		{"still running", runningBefore, runningBefore, true, false},
+	} {
+		server := togglmock.NewServer()
+		server.Handle("GET", "/api/v8/time_entries/current", http.StatusOK, tc.current)
+		if tc.after != "" {
+			server.Handle("GET", "/api/v8/time_entries/4000", http.StatusOK, tc.after)
+			server.Handle("PUT", "/api/v8/time_entries/4000", http.StatusOK, runningBefore)
+		}
+		// An entry stopped by the user exactly when the new one starts is no candidate
+		server.Handle("GET", "/api/v8/time_entries", http.StatusOK,
+			`[{"id":4999,"wid":1,"start":"2016-06-09T00:00:00+00:00","stop":"2016-06-09T01:00:00+00:00","duration":3600}]`)
+		var events []*client.ImplicitStopEvent
+		c, err := server.NewClient(
+			client.WithDefaultWorkspace(1),
+			client.WithReconciliation(func(e *client.ImplicitStopEvent) bool { return tc.restore }),
+			client.WithEventHandler(func(e client.Event) {
+				if e, ok := e.(*client.ImplicitStopEvent); ok {
+					events = append(events, e)
+				}
+			}),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		started, err := c.TimeEntries.Start(context.Background(), &client.TimeEntry{Description: "Writing fixtures"})
+		var conflict *client.StartConflictError
+		switch {
+		case tc.stopped && tc.restore:
+			if !errors.As(err, &conflict) || conflict.Running.ID != 4000 {
+				t.Errorf("%s: Start() = %v, want a conflict with 4000", tc.name, err)
+			}
+		case err != nil || started == nil || started.ID != 5000:
+			t.Errorf("%s: Start() = %+v, %v, want entry 5000", tc.name, started, err)
+		}
+		if !tc.stopped && len(events) != 0 || tc.stopped && (len(events) != 1 || events[0].Stopped.ID != 4000 || events[0].Restored != tc.restore) {
+			t.Errorf("%s: events = %+v", tc.name, events)
+		}
+		deleted := len(server.RequestsTo("DELETE", "/api/v8/time_entries/5000"))
+		if want := tc.stopped && tc.restore; (deleted == 1) != want || len(server.RequestsTo("PUT", "/api/v8/time_entries/4000")) != deleted {
+			t.Errorf("%s: new entry deleted %d times, want %v", tc.name, deleted, want)
+		}
+		if got := len(server.RequestsTo("PUT", "/api/v8/time_entries/4999")); got != 0 {
+			t.Errorf("%s: the entry stopped by the user was rewritten", tc.name)
+		}
+		server.Close()
+	}
+}
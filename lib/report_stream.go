@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// streamDecoder is a response body decoded while it is read, instead of as a whole by send.
+type streamDecoder interface {
+	decodeStream(r io.Reader) error
+}
+
+// detailedStream decodes a page of the detailed report, calling fn with every time entry of data.
+type detailedStream struct {
+	newDecoder func(io.Reader) *json.Decoder
+	report     *DetailedReport
+	entry      ReportTimeEntry
+	fn         func(*ReportTimeEntry) error
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return fmt.Errorf("detailed report: expected %v, got %v", want, tok)
+	}
+	return nil
+}
+
+func (s *detailedStream) decodeStream(r io.Reader) error {
+	dec := s.newDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+		switch key {
+		case "total_grand":
+			err = dec.Decode(&s.report.TotalGrand)
+		case "total_billable":
+			err = dec.Decode(&s.report.TotalBillable)
+		case "total_count":
+			err = dec.Decode(&s.report.TotalCount)
+		case "per_page":
+			err = dec.Decode(&s.report.PerPage)
+		case "total_currencies":
+			err = dec.Decode(&s.report.TotalCurrencies)
+		case "data":
+			err = s.decodeData(dec)
+		default:
+			var skip json.RawMessage
+			err = dec.Decode(&skip)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, '}')
+}
+
+func (s *detailedStream) decodeData(dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil || tok == nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '[' {
+		return fmt.Errorf("detailed report: expected data array, got %v", tok)
+	}
+	for dec.More() {
+		s.entry = ReportTimeEntry{}
+		if err := dec.Decode(&s.entry); err != nil {
+			return err
+		}
+		if err := s.fn(&s.entry); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, ']')
+}
+
+// StreamDetailed fetches a page of the detailed report and calls fn with its time entries
+// as they are decoded, without holding the page in memory. The entry given to fn is reused
+// once fn returns. It stops at the first error of fn, which is returned as is.
+// The returned report has the totals of the page without Data.
+func (s *ReportsService) StreamDetailed(ctx context.Context, params *ReportParams, fn func(*ReportTimeEntry) error) (*DetailedReport, error) {
+	var fnErr error
+	stream := &detailedStream{newDecoder: s.client.jsonDecoder, report: &DetailedReport{}, fn: func(e *ReportTimeEntry) error {
+		fnErr = fn(e)
+		return fnErr
+	}}
+	err := s.report(ctx, endpointReportDetailed, params, stream)
+	if fnErr != nil {
+		return nil, fnErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return stream.report, nil
+}
+
+// DetailedEntries sends every time entry of the detailed report on the returned channel, from params.Page
+// to the last page, streaming each page like StreamDetailed. The error channel receives the outcome,
+// nil or a *PartialResultError, once the entries channel is closed. Cancel ctx to stop early.
+func (s *ReportsService) DetailedEntries(ctx context.Context, params *ReportParams) (<-chan ReportTimeEntry, <-chan error) {
+	entries := make(chan ReportTimeEntry)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(errc)
+		err := s.EachDetailed(ctx, params, func(e *ReportTimeEntry) error {
+			select {
+			case entries <- *e:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		close(entries)
+		errc <- err
+	}()
+	return entries, errc
+}
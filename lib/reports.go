@@ -0,0 +1,363 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const reportDateFormat = "2006-01-02"
+
+// ReportParams are the request parameters shared by the reports endpoints
+type ReportParams struct {
+	// WorkspaceID is the workspace of the report, the default workspace of the client when 0
+	WorkspaceID int
+	Since       time.Time
+	Until       time.Time
+	UserIDs     []int
+	ClientIDs   []int
+	ProjectIDs  []int
+	TagIDs      []int
+	// Billable is one of "yes", "no" or "both"
+	Billable    string
+	Description string
+	Grouping    string
+	Subgrouping string
+	OrderField  string
+	OrderDesc   bool
+	// Page is the page of the detailed report, starting from 1
+	Page int
+
+	// Audit filters of the detailed report, to find suspect entries, ignored by the other reports.
+	// WithoutDescription keeps entries without description only.
+	// Grouped merges entries of the same description, project, task, tags and billable into one row.
+	WithoutDescription bool
+	Grouped            bool
+	DurationFilter
+
+	// RequireAdmin runs Preflight before the report and fails with a
+	// *ReportsPermissionError when the token is not an admin of the workspace.
+	RequireAdmin bool
+}
+
+// DurationFilter keeps report entries at least or at most as long, 0 for no limit.
+// It is shared by the detailed reports of the API v2 and v3.
+type DurationFilter struct {
+	MinDurationSeconds int `json:"min_duration_seconds,omitempty"`
+	MaxDurationSeconds int `json:"max_duration_seconds,omitempty"`
+}
+
+func joinIDs(ids []int) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.Itoa(id)
+	}
+	return strings.Join(s, ",")
+}
+
+// resolve returns a copy of params with the workspace ID set, or the default workspace of c.
+func (p *ReportParams) resolve(c *Client) (*ReportParams, error) {
+	workspaceID, err := c.workspace(p.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *p
+	resolved.WorkspaceID = workspaceID
+	return &resolved, nil
+}
+
+func (p *ReportParams) values() url.Values {
+	v := url.Values{}
+	v.Set("workspace_id", strconv.Itoa(p.WorkspaceID))
+	v.Set("user_agent", userAgent)
+	if !p.Since.IsZero() {
+		v.Set("since", p.Since.Format(reportDateFormat))
+	}
+	if !p.Until.IsZero() {
+		v.Set("until", p.Until.Format(reportDateFormat))
+	}
+	if len(p.UserIDs) > 0 {
+		v.Set("user_ids", joinIDs(p.UserIDs))
+	}
+	if len(p.ClientIDs) > 0 {
+		v.Set("client_ids", joinIDs(p.ClientIDs))
+	}
+	if len(p.ProjectIDs) > 0 {
+		v.Set("project_ids", joinIDs(p.ProjectIDs))
+	}
+	if len(p.TagIDs) > 0 {
+		v.Set("tag_ids", joinIDs(p.TagIDs))
+	}
+	if p.Billable != "" {
+		v.Set("billable", p.Billable)
+	}
+	if p.Description != "" {
+		v.Set("description", p.Description)
+	}
+	if p.Grouping != "" {
+		v.Set("grouping", p.Grouping)
+	}
+	if p.Subgrouping != "" {
+		v.Set("subgrouping", p.Subgrouping)
+	}
+	if p.OrderField != "" {
+		v.Set("order_field", p.OrderField)
+	}
+	if p.OrderDesc {
+		v.Set("order_desc", "on")
+	}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+	return v
+}
+
+// query returns the query string of the report endpoint, with the audit filters for the detailed report.
+func (p *ReportParams) query(endpoint string) string {
+	v := p.values()
+	if endpoint != endpointReportDetailed {
+		return v.Encode()
+	}
+	if p.WithoutDescription {
+		v.Set("without_description", "true")
+	}
+	if p.Grouped {
+		v.Set("grouped", "true")
+	}
+	if p.MinDurationSeconds > 0 {
+		v.Set("min_duration_seconds", strconv.Itoa(p.MinDurationSeconds))
+	}
+	if p.MaxDurationSeconds > 0 {
+		v.Set("max_duration_seconds", strconv.Itoa(p.MaxDurationSeconds))
+	}
+	return v.Encode()
+}
+
+// ReportCurrency is a total amount in a currency
+type ReportCurrency struct {
+	Currency string  `json:"currency"`
+	Amount   float64 `json:"amount"`
+}
+
+// ReportTitle names the group of a report row
+type ReportTitle struct {
+	Project   string `json:"project,omitempty"`
+	Client    string `json:"client,omitempty"`
+	User      string `json:"user,omitempty"`
+	TimeEntry string `json:"time_entry,omitempty"`
+}
+
+// WeeklyRow is a row of the weekly report.
+// Totals has 8 elements in milliseconds, one per day and the total of the week, nil for no time.
+type WeeklyRow struct {
+	Title     ReportTitle `json:"title"`
+	ProjectID int         `json:"pid,omitempty"`
+	UserID    int         `json:"uid,omitempty"`
+	Totals    []*int64    `json:"totals"`
+	Details   []WeeklyRow `json:"details,omitempty"`
+}
+
+// WeeklyReport is the response of the weekly report
+type WeeklyReport struct {
+	TotalGrand      int64            `json:"total_grand"`
+	TotalBillable   int64            `json:"total_billable"`
+	TotalCurrencies []ReportCurrency `json:"total_currencies"`
+	Data            []WeeklyRow      `json:"data"`
+}
+
+// ReportTimeEntry is a time entry of the detailed report. Dur is in milliseconds.
+type ReportTimeEntry struct {
+	ID          int64     `json:"id"`
+	ProjectID   int       `json:"pid"`
+	TaskID      int       `json:"tid"`
+	UserID      int       `json:"uid"`
+	Description string    `json:"description"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	Updated     time.Time `json:"updated"`
+	Dur         int64     `json:"dur"`
+	User        string    `json:"user"`
+	UseStop     bool      `json:"use_stop"`
+	Client      string    `json:"client"`
+	Project     string    `json:"project"`
+	Task        string    `json:"task"`
+	Billable    float64   `json:"billable"`
+	IsBillable  bool      `json:"is_billable"`
+	Currency    string    `json:"cur"`
+	Tags        []string  `json:"tags"`
+}
+
+// DetailedReport is a page of the detailed report
+type DetailedReport struct {
+	TotalGrand      int64             `json:"total_grand"`
+	TotalBillable   int64             `json:"total_billable"`
+	TotalCount      int               `json:"total_count"`
+	PerPage         int               `json:"per_page"`
+	TotalCurrencies []ReportCurrency  `json:"total_currencies"`
+	Data            []ReportTimeEntry `json:"data"`
+}
+
+// SummaryItem is a sub group of a summary row
+type SummaryItem struct {
+	Title    ReportTitle `json:"title"`
+	Time     int64       `json:"time"`
+	Currency string      `json:"cur"`
+	Sum      float64     `json:"sum"`
+	Rate     float64     `json:"rate"`
+}
+
+// SummaryRow is a group of the summary report. Time is in milliseconds.
+type SummaryRow struct {
+	ID    int           `json:"id"`
+	Title ReportTitle   `json:"title"`
+	Time  int64         `json:"time"`
+	Items []SummaryItem `json:"items"`
+}
+
+// SummaryReport is the response of the summary report
+type SummaryReport struct {
+	TotalGrand      int64            `json:"total_grand"`
+	TotalBillable   int64            `json:"total_billable"`
+	TotalCurrencies []ReportCurrency `json:"total_currencies"`
+	Data            []SummaryRow     `json:"data"`
+}
+
+// ReportsService handles the reports API v2
+type ReportsService struct {
+	client *Client
+}
+
+func (s *ReportsService) report(ctx context.Context, endpoint string, params *ReportParams, body interface{}) error {
+	params, err := params.resolve(s.client)
+	if err != nil {
+		return err
+	}
+	if params.RequireAdmin {
+		if err := s.Preflight(ctx, params.WorkspaceID); err != nil {
+			return err
+		}
+	}
+	return s.client.get(ctx, endpoint+"?"+params.query(endpoint), body)
+}
+
+// Weekly returns the weekly report.
+func (s *ReportsService) Weekly(ctx context.Context, params *ReportParams) (*WeeklyReport, error) {
+	report := &WeeklyReport{}
+	if err := s.report(ctx, endpointReportWeekly, params, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Detailed returns a page of the detailed report.
+func (s *ReportsService) Detailed(ctx context.Context, params *ReportParams) (*DetailedReport, error) {
+	report := &DetailedReport{}
+	if err := s.report(ctx, endpointReportDetailed, params, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Summary returns the summary report.
+func (s *ReportsService) Summary(ctx context.Context, params *ReportParams) (*SummaryReport, error) {
+	report := &SummaryReport{}
+	if err := s.report(ctx, endpointReportSummary, params, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// ReportFormat is a file format reports can be downloaded in
+type ReportFormat string
+
+// Report formats
+const (
+	ReportCSV ReportFormat = "csv"
+	ReportPDF ReportFormat = "pdf"
+)
+
+func (f ReportFormat) mediaType() string {
+	if f == ReportPDF {
+		return "application/pdf"
+	}
+	return "text/csv"
+}
+
+func (s *ReportsService) download(ctx context.Context, endpoint string, format ReportFormat, params *ReportParams, w io.Writer) error {
+	params, err := params.resolve(s.client)
+	if err != nil {
+		return err
+	}
+	if params.RequireAdmin {
+		if err := s.Preflight(ctx, params.WorkspaceID); err != nil {
+			return err
+		}
+	}
+	rawurl := endpoint + "." + string(format) + "?" + params.query(endpoint)
+	return s.client.do(ctx, "GET", rawurl, nil, w, withAccept(format.mediaType()))
+}
+
+// DownloadWeekly writes the weekly report in format to w.
+func (s *ReportsService) DownloadWeekly(ctx context.Context, params *ReportParams, format ReportFormat, w io.Writer) error {
+	return s.download(ctx, endpointReportWeekly, format, params, w)
+}
+
+// DownloadDetailed writes a page of the detailed report in format to w.
+func (s *ReportsService) DownloadDetailed(ctx context.Context, params *ReportParams, format ReportFormat, w io.Writer) error {
+	return s.download(ctx, endpointReportDetailed, format, params, w)
+}
+
+// DownloadSummary writes the summary report in format to w.
+func (s *ReportsService) DownloadSummary(ctx context.Context, params *ReportParams, format ReportFormat, w io.Writer) error {
+	return s.download(ctx, endpointReportSummary, format, params, w)
+}
+
+// ReportsPermissionError tells that the token is not an admin of the workspace,
+// so the reports API leaves out data without failing.
+type ReportsPermissionError struct {
+	WorkspaceID int
+	Role        string
+	// Missing describes the data left out of reports for the role
+	Missing []string
+}
+
+func (e *ReportsPermissionError) Error() string {
+	return fmt.Sprintf("token is a %s of workspace %d, reports will not include %s",
+		e.Role, e.WorkspaceID, strings.Join(e.Missing, ", "))
+}
+
+// Preflight checks the role of the token in the workspace before running admin scoped reports.
+// It returns a *ReportsPermissionError when the token is not an admin.
+func (s *ReportsService) Preflight(ctx context.Context, workspaceID int) error {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return err
+	}
+	workspace, err := s.client.Workspaces.Get(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	if workspace == nil {
+		return ErrNoData
+	}
+	if workspace.Admin {
+		return nil
+	}
+
+	missing := []string{"time entries in private projects the user is not a member of"}
+	if workspace.OnlyAdminsSeeTeamDashboard {
+		missing = append(missing, "time entries of other users")
+	}
+	if workspace.OnlyAdminsSeeBillableRates {
+		missing = append(missing, "billable amounts and rates")
+	}
+	return &ReportsPermissionError{
+		WorkspaceID: workspaceID,
+		Role:        "member",
+		Missing:     missing,
+	}
+}
@@ -0,0 +1,114 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestReportAuditParams(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := &client.ReportParams{
+		WithoutDescription: true,
+		Grouped:            true,
+		DurationFilter:     client.DurationFilter{MinDurationSeconds: 60, MaxDurationSeconds: 36000},
+	}
+	ctx := context.Background()
+
+	if _, err := c.Reports.Detailed(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+	detailed := server.RequestsTo("GET", "/reports/api/v2/details")[0].Query
+	for key, want := range map[string]string{"without_description": "true", "grouped": "true", "min_duration_seconds": "60", "max_duration_seconds": "36000"} {
+		if got := detailed.Get(key); got != want {
+			t.Errorf("detailed %s = %q, want %q", key, got, want)
+		}
+	}
+
+	if _, err := c.Reports.Weekly(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+	weekly := server.RequestsTo("GET", "/reports/api/v2/weekly")[0].Query
+	for _, key := range []string{"without_description", "grouped", "min_duration_seconds", "max_duration_seconds"} {
+		if weekly.Has(key) {
+			t.Errorf("weekly report sent %s", key)
+		}
+	}
+}
+
+func TestReportsV3FilterJSON(t *testing.T) {
+	filter := client.ReportsV3Filter{WithoutDescription: true, DurationFilter: client.DurationFilter{MinDurationSeconds: 60}}
+	b, err := json.Marshal(filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(b); !strings.Contains(got, `"without_description":true`) || !strings.Contains(got, `"min_duration_seconds":60`) {
+		t.Errorf("filter = %s, want without_description and min_duration_seconds", got)
+	}
+}
+
+func TestPreflight(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	server.Handle("GET", "/api/v8/workspaces/*", http.StatusOK, `{"data":{"id":1,"name":"Team","admin":false,"only_admins_see_team_dashboard":true}}`)
+	var perr *client.ReportsPermissionError
+	if err := c.Reports.Preflight(ctx, 0); !errors.As(err, &perr) || len(perr.Missing) != 2 {
+		t.Errorf("Preflight as member = %v, want a *ReportsPermissionError missing 2 kinds of data", err)
+	}
+
+	server.Handle("GET", "/api/v8/workspaces/*", http.StatusOK, `{}`)
+	if err := c.Reports.Preflight(ctx, 0); err != client.ErrNoData {
+		t.Errorf("Preflight without workspace = %v, want ErrNoData", err)
+	}
+}
+
+func TestReportsSources(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	// Entries 5001 and 5002 of togglmock.FixtureReportDetailed, created with the desktop and the mobile app
+	server.Handle("GET", "/api/v8/time_entries/5001", http.StatusOK,
+		`{"data":{"id":5001,"wid":1,"uid":1000,"start":"2016-06-06T01:00:00Z","stop":"2016-06-06T02:00:00Z","duration":3600,"created_with":"TogglDesktop"}}`)
+	server.Handle("GET", "/api/v8/time_entries/5002", http.StatusOK,
+		`{"data":{"id":5002,"wid":1,"uid":1001,"start":"2016-06-07T01:00:00Z","stop":"2016-06-07T01:30:00Z","duration":1800,"created_with":"Toggl Track iOS"}}`)
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	breakdown, err := c.Reports.Sources(context.Background(), &client.ReportParams{UserIDs: []int{1000, 1001}}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(breakdown) != 2 || breakdown[0].Source != client.SourceDesktop || breakdown[0].Duration != time.Hour ||
+		breakdown[1].Source != client.SourceMobile || breakdown[1].Duration != 30*time.Minute {
+		t.Errorf("breakdown = %+v, want an hour of desktop and 30 minutes of mobile", breakdown)
+	}
+	if r := server.RequestsTo("GET", "/reports/api/v2/details"); len(r) != 1 || !strings.Contains(r[0].Query.Get("user_ids"), "1000,1001") {
+		t.Errorf("report requests = %+v, want one for both users", r)
+	}
+
+	server.InjectError("GET", "/api/v8/time_entries/5002", http.StatusForbidden, "forbidden")
+	breakdown, err = c.Reports.Sources(context.Background(), &client.ReportParams{}, 1)
+	var berr *client.BatchError[int64]
+	if !errors.As(err, &berr) || len(breakdown) != 1 || breakdown[0].Source != client.SourceDesktop {
+		t.Errorf("Sources() = %+v, %v, want the desktop entry and a BatchError of 5002", breakdown, err)
+	}
+}
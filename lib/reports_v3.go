@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ReportsV3Filter is the request body shared by the reports API v3 endpoints.
+// Dates are formatted as "2006-01-02". Zero values are left out.
+type ReportsV3Filter struct {
+	StartDate   string `json:"start_date,omitempty"`
+	EndDate     string `json:"end_date,omitempty"`
+	ProjectIDs  []int  `json:"project_ids,omitempty"`
+	ClientIDs   []int  `json:"client_ids,omitempty"`
+	UserIDs     []int  `json:"user_ids,omitempty"`
+	TagIDs      []int  `json:"tag_ids,omitempty"`
+	TaskIDs     []int  `json:"task_ids,omitempty"`
+	Billable    *bool  `json:"billable,omitempty"`
+	Description string `json:"description,omitempty"`
+	// WithoutDescription keeps entries without description only
+	WithoutDescription bool `json:"without_description,omitempty"`
+	DurationFilter
+	// Rounding is 0 for no rounding, 1 to round up, -1 to round down
+	Rounding        int  `json:"rounding,omitempty"`
+	RoundingMinutes int  `json:"rounding_minutes,omitempty"`
+	HideAmounts     bool `json:"hide_amounts,omitempty"`
+}
+
+// ReportsV3SearchParams is the request body of the detailed search
+type ReportsV3SearchParams struct {
+	ReportsV3Filter
+	// Grouped merges entries of the same description, project, task, tags and billable into one row
+	Grouped        bool   `json:"grouped,omitempty"`
+	OrderBy        string `json:"order_by,omitempty"`
+	OrderDir       string `json:"order_dir,omitempty"`
+	PageSize       int    `json:"page_size,omitempty"`
+	FirstID        int64  `json:"first_id,omitempty"`
+	FirstRowNumber int    `json:"first_row_number,omitempty"`
+	EnrichResponse bool   `json:"enrich_response,omitempty"`
+}
+
+// ReportsV3TimeEntry is a time entry of a detailed search row
+type ReportsV3TimeEntry struct {
+	ID      int64     `json:"id"`
+	Seconds int64     `json:"seconds"`
+	Start   time.Time `json:"start"`
+	Stop    time.Time `json:"stop"`
+	At      time.Time `json:"at"`
+}
+
+// ReportsV3Row is a row of the detailed search, time entries grouped by their attributes
+type ReportsV3Row struct {
+	UserID                int                  `json:"user_id"`
+	Username              string               `json:"username"`
+	ProjectID             int                  `json:"project_id"`
+	TaskID                int                  `json:"task_id"`
+	Billable              bool                 `json:"billable"`
+	Description           string               `json:"description"`
+	TagIDs                []int                `json:"tag_ids"`
+	BillableAmountInCents int64                `json:"billable_amount_in_cents"`
+	HourlyRateInCents     int64                `json:"hourly_rate_in_cents"`
+	Currency              string               `json:"currency"`
+	TimeEntries           []ReportsV3TimeEntry `json:"time_entries"`
+	RowNumber             int                  `json:"row_number"`
+}
+
+// ReportsV3SearchPage is a page of the detailed search.
+// NextID and NextRowNumber are zero on the last page.
+type ReportsV3SearchPage struct {
+	Rows          []ReportsV3Row
+	NextID        int64
+	NextRowNumber int
+}
+
+// Next returns the parameters of the next page, or nil on the last page.
+func (p *ReportsV3SearchPage) Next(params *ReportsV3SearchParams) *ReportsV3SearchParams {
+	if p.NextRowNumber == 0 {
+		return nil
+	}
+	next := *params
+	next.FirstID = p.NextID
+	next.FirstRowNumber = p.NextRowNumber
+	return &next
+}
+
+// ReportsV3SummaryParams is the request body of the summary
+type ReportsV3SummaryParams struct {
+	ReportsV3Filter
+	Grouping            string `json:"grouping,omitempty"`
+	SubGrouping         string `json:"sub_grouping,omitempty"`
+	IncludeTimeEntryIDs bool   `json:"include_time_entry_ids,omitempty"`
+}
+
+// ReportsV3SubGroup is a sub group of the summary
+type ReportsV3SubGroup struct {
+	ID           int     `json:"id"`
+	Title        string  `json:"title"`
+	Seconds      int64   `json:"seconds"`
+	Rates        []Rate  `json:"rates,omitempty"`
+	TimeEntryIDs []int64 `json:"ids,omitempty"`
+}
+
+// Rate is an hourly rate with the billable seconds tracked at it
+type Rate struct {
+	BillableSeconds   int64  `json:"billable_seconds"`
+	HourlyRateInCents int64  `json:"hourly_rate_in_cents"`
+	Currency          string `json:"currency"`
+}
+
+// ReportsV3Group is a group of the summary
+type ReportsV3Group struct {
+	ID        int                 `json:"id"`
+	SubGroups []ReportsV3SubGroup `json:"sub_groups"`
+}
+
+// ReportsV3Summary is the response of the summary
+type ReportsV3Summary struct {
+	Groups []ReportsV3Group `json:"groups"`
+}
+
+// ReportsV3Service handles the reports API v3, which adds billable amounts,
+// rounding and duration filters to the reports of ReportsService.
+type ReportsV3Service struct {
+	client *Client
+}
+
+func (s *ReportsV3Service) url(workspaceID int, path string) string {
+	return fmt.Sprintf("%s/%d/%s", endpointReportsV3, workspaceID, path)
+}
+
+// SearchTimeEntries returns a page of the detailed report.
+func (s *ReportsV3Service) SearchTimeEntries(ctx context.Context, workspaceID int, params *ReportsV3SearchParams) (*ReportsV3SearchPage, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	page := &ReportsV3SearchPage{}
+	resp, err := s.client.doResponse(ctx, "POST", s.url(workspaceID, "search/time_entries"), params, &page.Rows)
+	if err != nil {
+		return nil, err
+	}
+	page.NextID, _ = strconv.ParseInt(resp.Header.Get("X-Next-ID"), 10, 64)
+	page.NextRowNumber, _ = strconv.Atoi(resp.Header.Get("X-Next-Row-Number"))
+	return page, nil
+}
+
+// Summary returns the summary report.
+func (s *ReportsV3Service) Summary(ctx context.Context, workspaceID int, params *ReportsV3SummaryParams) (*ReportsV3Summary, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	summary := &ReportsV3Summary{}
+	err = s.client.do(ctx, "POST", s.url(workspaceID, "summary/time_entries"), params, summary)
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
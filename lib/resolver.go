@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MatchMode tells how the Resolver compares names
+type MatchMode int
+
+// Match modes of the Resolver
+const (
+	// MatchExact matches identical names only
+	MatchExact MatchMode = iota
+	// MatchFold ignores case, accents, white spaces and invisible characters, see FoldName
+	MatchFold
+	// MatchFuzzy is MatchFold, falling back to the only name which contains the given one
+	MatchFuzzy
+)
+
+// NameError is returned by the Resolver when a name matches no object or more than one
+type NameError struct {
+	Kind string
+	Name string
+	// Candidates are the names matched when the name is ambiguous
+	Candidates []string
+}
+
+func (e *NameError) Error() string {
+	if len(e.Candidates) == 0 {
+		return fmt.Sprintf("%s %q is not found", e.Kind, e.Name)
+	}
+	return fmt.Sprintf("%s %q is ambiguous: %s", e.Kind, e.Name, strings.Join(e.Candidates, ", "))
+}
+
+type namedID struct {
+	name string
+	id   int
+}
+
+// Resolver maps human readable names of a workspace to IDs.
+// Workspace metadata is fetched on every lookup, or kept in memory with WithLookupCache.
+type Resolver struct {
+	client      *Client
+	workspaceID int
+	mode        MatchMode
+}
+
+// NewResolver returns a Resolver of the workspace.
+func NewResolver(c *Client, workspaceID int, mode MatchMode) *Resolver {
+	return &Resolver{client: c, workspaceID: workspaceID, mode: mode}
+}
+
+// ProjectID returns the ID of the project.
+func (r *Resolver) ProjectID(ctx context.Context, name string) (int, error) {
+	projects, err := r.client.Projects.List(ctx, r.workspaceID)
+	if err != nil {
+		return 0, err
+	}
+	candidates := make([]namedID, len(projects))
+	for i, p := range projects {
+		candidates[i] = namedID{p.Name, p.ID}
+	}
+	return r.resolve("project", name, candidates)
+}
+
+// ClientID returns the ID of the client.
+func (r *Resolver) ClientID(ctx context.Context, name string) (int, error) {
+	clients, err := r.client.Clients.List(ctx, r.workspaceID)
+	if err != nil {
+		return 0, err
+	}
+	candidates := make([]namedID, len(clients))
+	for i, cl := range clients {
+		candidates[i] = namedID{cl.Name, cl.ID}
+	}
+	return r.resolve("client", name, candidates)
+}
+
+// TagIDs returns the IDs of the tags, in the given order.
+func (r *Resolver) TagIDs(ctx context.Context, names ...string) ([]int, error) {
+	tags, err := r.client.Tags.List(ctx, r.workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]namedID, len(tags))
+	for i, t := range tags {
+		candidates[i] = namedID{t.Name, t.ID}
+	}
+	ids := make([]int, len(names))
+	for i, name := range names {
+		ids[i], err = r.resolve("tag", name, candidates)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+func (r *Resolver) resolve(kind, name string, candidates []namedID) (int, error) {
+	for _, c := range candidates {
+		if c.name == name {
+			return c.id, nil
+		}
+	}
+	if r.mode == MatchExact {
+		return 0, &NameError{Kind: kind, Name: name}
+	}
+
+	key := FoldName(name)
+	var folded, contained []namedID
+	for _, c := range candidates {
+		k := FoldName(c.name)
+		if k == key {
+			folded = append(folded, c)
+		} else if r.mode == MatchFuzzy && key != "" && strings.Contains(k, key) {
+			contained = append(contained, c)
+		}
+	}
+	if len(folded) == 0 {
+		folded = contained
+	}
+	switch len(folded) {
+	case 0:
+		return 0, &NameError{Kind: kind, Name: name}
+	case 1:
+		return folded[0].id, nil
+	}
+	names := make([]string, len(folded))
+	for i, c := range folded {
+		names[i] = c.name
+	}
+	sort.Strings(names)
+	return 0, &NameError{Kind: kind, Name: name, Candidates: names}
+}
@@ -0,0 +1,71 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestResolver(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	server.Handle("GET", "/api/v8/workspaces/*/projects", http.StatusOK, `[
+ {"id":100,"wid":1,"name":"Website","active":true},
+ {"id":101,"wid":1,"name":"Support","active":true},
+ {"id":103,"wid":1,"name":"Website Redesign","active":true},
+ {"id":104,"wid":1,"name":"Café","active":true},
+ {"id":105,"wid":1,"name":"Support Tier 2","active":true}
+]`)
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		mode       client.MatchMode
+		name       string
+		id         int
+		candidates []string
+	}{
+		{client.MatchExact, "Website", 100, nil},
+		{client.MatchExact, "website", 0, nil},
+		{client.MatchFold, "Website", 100, nil},
+		{client.MatchFold, " WEBSITE ", 100, nil},
+		{client.MatchFold, "cafe", 104, nil},
+		{client.MatchFold, "Web", 0, nil},
+		{client.MatchFuzzy, "support", 101, nil},
+		{client.MatchFuzzy, "redesign", 103, nil},
+		{client.MatchFuzzy, "SUP", 0, []string{"Support", "Support Tier 2"}},
+		{client.MatchFuzzy, "Intranet", 0, nil},
+	} {
+		id, err := client.NewResolver(c, 0, tc.mode).ProjectID(ctx, tc.name)
+		if tc.id != 0 {
+			if err != nil || id != tc.id {
+				t.Errorf("mode %d: ProjectID(%q) = %d, %v, want %d", tc.mode, tc.name, id, err, tc.id)
+			}
+			continue
+		}
+		var nerr *client.NameError
+		if !errors.As(err, &nerr) || nerr.Kind != "project" || nerr.Name != tc.name || !reflect.DeepEqual(nerr.Candidates, tc.candidates) {
+			t.Errorf("mode %d: ProjectID(%q) = %d, %v, want a NameError with candidates %v", tc.mode, tc.name, id, err, tc.candidates)
+		}
+	}
+
+	// togglmock.FixtureTags are dev (20) and meeting (21), togglmock.FixtureClients Acme (10) and Globex (11)
+	r := client.NewResolver(c, 0, client.MatchFold)
+	if ids, err := r.TagIDs(ctx, "Meeting", "dev"); err != nil || !reflect.DeepEqual(ids, []int{21, 20}) {
+		t.Errorf("TagIDs() = %v, %v, want [21 20]", ids, err)
+	}
+	if _, err := r.TagIDs(ctx, "dev", "travel"); err == nil || err.Error() != `tag "travel" is not found` {
+		t.Errorf("TagIDs() of an unknown tag = %v", err)
+	}
+	if id, err := r.ClientID(ctx, "globex"); err != nil || id != 11 {
+		t.Errorf("ClientID() = %d, %v, want 11", id, err)
+	}
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const sharedReportURL = "https://track.toggl.com/shared-report/"
+
+// SavedReportParams are the filters stored in a saved report
+type SavedReportParams struct {
+	StartDate   string `json:"start_date,omitempty"`
+	EndDate     string `json:"end_date,omitempty"`
+	ProjectIDs  []int  `json:"project_ids,omitempty"`
+	ClientIDs   []int  `json:"client_ids,omitempty"`
+	UserIDs     []int  `json:"user_ids,omitempty"`
+	TagIDs      []int  `json:"tag_ids,omitempty"`
+	Billable    *bool  `json:"billable,omitempty"`
+	Description string `json:"description,omitempty"`
+	Grouping    string `json:"grouping,omitempty"`
+	SubGrouping string `json:"sub_grouping,omitempty"`
+}
+
+// SavedReport is a report saved in a workspace, shared by its token
+type SavedReport struct {
+	Token       string `json:"report_token,omitempty"`
+	WorkspaceID int    `json:"workspace_id"`
+	Name        string `json:"name"`
+	// ReportType is one of "detailed", "summary" and "weekly"
+	ReportType     string            `json:"report_type"`
+	Public         bool              `json:"public"`
+	FixedDateRange bool              `json:"fixed_daterange"`
+	Params         SavedReportParams `json:"params"`
+	CreatedAt      *time.Time        `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time        `json:"updated_at,omitempty"`
+}
+
+// SharedURL returns the link the report is shared with, empty until it has a token.
+func (r *SavedReport) SharedURL() string {
+	if r.Token == "" {
+		return ""
+	}
+	return sharedReportURL + r.Token
+}
+
+// ReportParams returns the parameters to run the report again with ReportsService.
+func (r *SavedReport) ReportParams() (*ReportParams, error) {
+	params := &ReportParams{
+		WorkspaceID: r.WorkspaceID,
+		UserIDs:     r.Params.UserIDs,
+		ClientIDs:   r.Params.ClientIDs,
+		ProjectIDs:  r.Params.ProjectIDs,
+		TagIDs:      r.Params.TagIDs,
+		Description: r.Params.Description,
+		Grouping:    r.Params.Grouping,
+		Subgrouping: r.Params.SubGrouping,
+	}
+	var err error
+	if r.Params.StartDate != "" {
+		if params.Since, err = time.Parse(reportDateFormat, r.Params.StartDate); err != nil {
+			return nil, err
+		}
+	}
+	if r.Params.EndDate != "" {
+		if params.Until, err = time.Parse(reportDateFormat, r.Params.EndDate); err != nil {
+			return nil, err
+		}
+	}
+	if r.Params.Billable != nil {
+		params.Billable = "no"
+		if *r.Params.Billable {
+			params.Billable = "yes"
+		}
+	}
+	return params, nil
+}
+
+// ReportsV3Filter returns the filter to run the report again with ReportsV3Service.
+func (r *SavedReport) ReportsV3Filter() ReportsV3Filter {
+	return ReportsV3Filter{
+		StartDate:   r.Params.StartDate,
+		EndDate:     r.Params.EndDate,
+		ProjectIDs:  r.Params.ProjectIDs,
+		ClientIDs:   r.Params.ClientIDs,
+		UserIDs:     r.Params.UserIDs,
+		TagIDs:      r.Params.TagIDs,
+		Billable:    r.Params.Billable,
+		Description: r.Params.Description,
+	}
+}
+
+// SavedReportsService handles saved and shared reports of the reports API v3
+type SavedReportsService struct {
+	client *Client
+}
+
+// List returns saved reports of the workspace.
+func (s *SavedReportsService) List(ctx context.Context, workspaceID int) ([]SavedReport, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	var reports []SavedReport
+	err = s.client.get(ctx, fmt.Sprintf("%s/%d/shared", endpointReportsV3, workspaceID), &reports)
+	return reports, err
+}
+
+// Get returns the saved report shared with the token.
+func (s *SavedReportsService) Get(ctx context.Context, token string) (*SavedReport, error) {
+	report := &SavedReport{}
+	if err := s.client.get(ctx, endpointReportsShared+"/"+token, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Create saves the report and returns it with the token it is shared with.
+func (s *SavedReportsService) Create(ctx context.Context, report *SavedReport) (*SavedReport, error) {
+	r := *report
+	workspaceID, err := s.client.workspace(r.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	r.WorkspaceID = workspaceID
+	created := &SavedReport{}
+	if err := s.client.do(ctx, "POST", endpointReportsShared, &r, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Delete deletes the saved report, which stops sharing it.
+func (s *SavedReportsService) Delete(ctx context.Context, workspaceID int, token string) error {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return err
+	}
+	body := struct {
+		WorkspaceID int    `json:"workspace_id"`
+		Token       string `json:"report_token"`
+	}{workspaceID, token}
+	return s.client.do(ctx, "DELETE", endpointReportsShared, body, nil)
+}
@@ -0,0 +1,166 @@
+// Package schema defines the versioned JSON documents written by the command, exporters and digests.
+// Fields of a schema version are never renamed or removed, so scripts reading the output keep working
+// when the API models of the client change. Incompatible changes bump Version.
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+)
+
+// Version is the schema version written in the schema_version field
+const Version = 1
+
+// Kinds of documents
+const (
+	KindTimeEntry = "time_entry"
+	KindTotals    = "totals"
+)
+
+// TimeEntry is the output record of a time entry.
+// Each record carries schema_version so streams like JSON Lines are self describing.
+type TimeEntry struct {
+	SchemaVersion   int        `json:"schema_version"`
+	Kind            string     `json:"kind"`
+	ID              int64      `json:"id"`
+	WorkspaceID     int        `json:"workspace_id,omitempty"`
+	ProjectID       int        `json:"project_id,omitempty"`
+	Project         string     `json:"project,omitempty"`
+	Client          string     `json:"client,omitempty"`
+	UserID          int        `json:"user_id,omitempty"`
+	User            string     `json:"user,omitempty"`
+	Description     string     `json:"description"`
+	Start           time.Time  `json:"start"`
+	Stop            *time.Time `json:"stop,omitempty"`
+	DurationSeconds int64      `json:"duration_seconds"`
+	Running         bool       `json:"running"`
+	Billable        bool       `json:"billable"`
+	Tags            []string   `json:"tags"`
+}
+
+// Total is tracked time of a group, e.g. a project or a client
+type Total struct {
+	Name            string  `json:"name"`
+	Client          string  `json:"client,omitempty"`
+	ID              int     `json:"id,omitempty"`
+	DurationSeconds int64   `json:"duration_seconds"`
+	BillableAmount  float64 `json:"billable_amount,omitempty"`
+	Currency        string  `json:"currency,omitempty"`
+}
+
+// Totals is the output document of grouped totals over a period
+type Totals struct {
+	SchemaVersion   int       `json:"schema_version"`
+	Kind            string    `json:"kind"`
+	WorkspaceID     int       `json:"workspace_id"`
+	Since           time.Time `json:"since"`
+	Until           time.Time `json:"until"`
+	DurationSeconds int64     `json:"duration_seconds"`
+	Groups          []Total   `json:"groups"`
+}
+
+// Check returns an error when a document of the given version can not be read by this package.
+func Check(version int) error {
+	if version < 1 || version > Version {
+		return fmt.Errorf("schema version %d is not supported, expected 1 to %d", version, Version)
+	}
+	return nil
+}
+
+// Check returns an error when the record is not a time entry of a version this package can read.
+func (e *TimeEntry) Check() error {
+	if err := Check(e.SchemaVersion); err != nil {
+		return err
+	}
+	if e.Kind != KindTimeEntry {
+		return fmt.Errorf("record of kind %q is no %s", e.Kind, KindTimeEntry)
+	}
+	return nil
+}
+
+func tags(t []string) []string {
+	if t == nil {
+		return []string{}
+	}
+	return t
+}
+
+// FromTimeEntry converts a time entry of the API.
+func FromTimeEntry(e *client.TimeEntry) TimeEntry {
+	out := TimeEntry{
+		SchemaVersion:   Version,
+		Kind:            KindTimeEntry,
+		ID:              e.ID,
+		WorkspaceID:     e.WorkspaceID,
+		ProjectID:       e.ProjectID,
+		UserID:          e.UserID,
+		Description:     e.Description,
+		Start:           e.Start,
+		DurationSeconds: int64(e.Duration / time.Second),
+		Running:         e.IsRunning(),
+		Billable:        e.Billable,
+		Tags:            tags(e.Tags),
+	}
+	if !e.Stop.IsZero() {
+		stop := e.Stop
+		out.Stop = &stop
+	}
+	return out
+}
+
+// FromReportTimeEntry converts a time entry of the detailed report.
+func FromReportTimeEntry(e *client.ReportTimeEntry) TimeEntry {
+	out := TimeEntry{
+		SchemaVersion:   Version,
+		Kind:            KindTimeEntry,
+		ID:              e.ID,
+		ProjectID:       e.ProjectID,
+		Project:         e.Project,
+		Client:          e.Client,
+		UserID:          e.UserID,
+		User:            e.User,
+		Description:     e.Description,
+		Start:           e.Start,
+		DurationSeconds: e.Dur / 1000,
+		Billable:        e.IsBillable,
+		Tags:            tags(e.Tags),
+	}
+	if !e.End.IsZero() {
+		end := e.End
+		out.Stop = &end
+	}
+	return out
+}
+
+// FromSummaryReport converts a summary report grouped by projects or clients.
+func FromSummaryReport(params *client.ReportParams, r *client.SummaryReport) *Totals {
+	totals := &Totals{
+		SchemaVersion:   Version,
+		Kind:            KindTotals,
+		WorkspaceID:     params.WorkspaceID,
+		Since:           params.Since,
+		Until:           params.Until,
+		DurationSeconds: r.TotalGrand / 1000,
+		Groups:          make([]Total, 0, len(r.Data)),
+	}
+	for _, row := range r.Data {
+		total := Total{
+			Name:            row.Title.Project,
+			Client:          row.Title.Client,
+			ID:              row.ID,
+			DurationSeconds: row.Time / 1000,
+		}
+		if total.Name == "" {
+			total.Name = row.Title.Client
+			total.Client = ""
+		}
+		for _, item := range row.Items {
+			total.BillableAmount += item.Sum
+			total.Currency = item.Currency
+		}
+		totals.Groups = append(totals.Groups, total)
+	}
+	return totals
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+)
+
+func TestCheck(t *testing.T) {
+	for _, tc := range []struct {
+		rec TimeEntry
+		ok  bool
+	}{
+		{TimeEntry{SchemaVersion: Version, Kind: KindTimeEntry}, true},
+		{TimeEntry{SchemaVersion: 0, Kind: KindTimeEntry}, false},
+		{TimeEntry{SchemaVersion: Version + 1, Kind: KindTimeEntry}, false},
+		{TimeEntry{SchemaVersion: Version, Kind: KindTotals}, false},
+		{TimeEntry{SchemaVersion: Version}, false},
+	} {
+		if err := tc.rec.Check(); (err == nil) != tc.ok {
+			t.Errorf("Check() of version %d kind %q = %v", tc.rec.SchemaVersion, tc.rec.Kind, err)
+		}
+	}
+}
+
+func TestFromReportTimeEntry(t *testing.T) {
+	var row client.ReportTimeEntry
+	if err := json.Unmarshal([]byte(`{"id":5001,"pid":100,"tid":null,"uid":1000,"description":"Landing page",
+ "start":"2016-06-06T10:00:00+09:00","end":"2016-06-06T11:00:00+09:00","dur":3600500,"user":"Test User",
+ "client":"Acme","project":"Website","is_billable":true,"tags":null}`), &row); err != nil {
+		t.Fatal(err)
+	}
+	got := FromReportTimeEntry(&row)
+	stop := row.End
+	want := TimeEntry{
+		SchemaVersion:   Version,
+		Kind:            KindTimeEntry,
+		ID:              5001,
+		ProjectID:       100,
+		Project:         "Website",
+		Client:          "Acme",
+		UserID:          1000,
+		User:            "Test User",
+		Description:     "Landing page",
+		Start:           row.Start,
+		Stop:            &stop,
+		DurationSeconds: 3600,
+		Billable:        true,
+		Tags:            []string{},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromReportTimeEntry() = %+v, want %+v", got, want)
+	}
+	if err := got.Check(); err != nil {
+		t.Error(err)
+	}
+
+	// Tags are an empty list rather than null, and a row without end has no stop
+	row.End = time.Time{}
+	b, err := json.Marshal(FromReportTimeEntry(&row))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if tags, ok := doc["tags"].([]interface{}); !ok || len(tags) != 0 {
+		t.Errorf("tags = %v, want []", doc["tags"])
+	}
+	if _, ok := doc["stop"]; ok {
+		t.Errorf("stop = %v, want none", doc["stop"])
+	}
+	if doc["schema_version"] != float64(Version) || doc["kind"] != KindTimeEntry {
+		t.Errorf("document = %v, want schema_version %d and kind %s", doc, Version, KindTimeEntry)
+	}
+}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Source is the kind of application a time entry was created with
+type Source string
+
+// Sources of time entries, classified from created_with
+const (
+	SourceWeb       Source = "web"
+	SourceMobile    Source = "mobile"
+	SourceDesktop   Source = "desktop"
+	SourceExtension Source = "browser extension"
+	SourceLibrary   Source = "toggl-go"
+	SourceOther     Source = "other integration"
+	SourceUnknown   Source = "unknown"
+)
+
+// knownSources are the words of created_with values naming an application.
+// Values are split into words at every character which is not a letter or a digit.
+var knownSources = map[string]Source{
+	"ios": SourceMobile, "android": SourceMobile, "mobile": SourceMobile,
+	"toggldesktop": SourceDesktop, "desktop": SourceDesktop, "windows": SourceDesktop,
+	"mac": SourceDesktop, "macos": SourceDesktop, "linux": SourceDesktop,
+	"togglbutton": SourceExtension, "button": SourceExtension, "extension": SourceExtension,
+	"chrome": SourceExtension, "firefox": SourceExtension,
+	"web": SourceWeb, "webapp": SourceWeb, "snowball": SourceWeb,
+}
+
+// ClassifySource returns the source of a created_with value, e.g. "TogglDesktop" or "toggl-go/1.2".
+// Only whole words are matched, so "Machinery" is no desktop application.
+func ClassifySource(app string) Source {
+	s := strings.ToLower(app)
+	if s == "" {
+		return SourceUnknown
+	}
+	if s == createdWith || strings.HasPrefix(s, createdWith+"/") || strings.HasPrefix(s, createdWith+" ") {
+		return SourceLibrary
+	}
+	words := strings.FieldsFunc(s, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+	for _, w := range words {
+		if source, ok := knownSources[w]; ok {
+			return source
+		}
+	}
+	return SourceOther
+}
+
+// SourceTotal is tracked time of the entries created with a source
+type SourceTotal struct {
+	Source   Source
+	Duration time.Duration
+	Entries  int
+	// Applications is tracked time per created_with value of the source
+	Applications map[string]time.Duration
+}
+
+// SourceBreakdown totals tracked time of entries by source, longest first.
+// Running entries count up to now.
+func SourceBreakdown(entries []TimeEntry, now time.Time) []SourceTotal {
+	totals := map[Source]*SourceTotal{}
+	for i := range entries {
+		e := &entries[i]
+		source := ClassifySource(e.CreatedWith)
+		t, ok := totals[source]
+		if !ok {
+			t = &SourceTotal{Source: source, Applications: map[string]time.Duration{}}
+			totals[source] = t
+		}
+		elapsed := e.Elapsed(now)
+		t.Duration += elapsed
+		t.Entries++
+		t.Applications[e.CreatedWith] += elapsed
+	}
+
+	breakdown := make([]SourceTotal, 0, len(totals))
+	for _, t := range totals {
+		breakdown = append(breakdown, *t)
+	}
+	sort.Slice(breakdown, func(i, j int) bool {
+		if breakdown[i].Duration != breakdown[j].Duration {
+			return breakdown[i].Duration > breakdown[j].Duration
+		}
+		return breakdown[i].Source < breakdown[j].Source
+	})
+	return breakdown
+}
+
+// OwnSources returns the source breakdown of the time entries of the token owner started between
+// start and end, in a single request. See ReportsService.Sources for the entries of other members.
+func (s *TimeEntriesService) OwnSources(ctx context.Context, start, end time.Time) ([]SourceTotal, error) {
+	entries, err := s.List(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return SourceBreakdown(entries, time.Now()), nil
+}
+
+// Sources returns the source breakdown of the time entries in the detailed report of params,
+// those of all members of the workspace or of params.UserIDs, which needs an admin token.
+// The report does not tell created_with, so every entry is then fetched by its ID on workers
+// goroutines, DefaultBatchWorkers when 0. Entries which fail are reported by a *BatchError
+// returned along with the breakdown of the others.
+func (s *ReportsService) Sources(ctx context.Context, params *ReportParams, workers int) ([]SourceTotal, error) {
+	var ids []int64
+	err := s.EachDetailed(ctx, params, func(e *ReportTimeEntry) error {
+		ids = append(ids, e.ID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	fetched, err := Batch(ctx, workers, ids, s.client.TimeEntries.Get)
+	entries := make([]TimeEntry, 0, len(fetched))
+	for _, id := range distinct(ids) {
+		if e := fetched[id]; e != nil {
+			entries = append(entries, *e)
+		}
+	}
+	return SourceBreakdown(entries, time.Now()), err
+}
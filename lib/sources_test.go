@@ -0,0 +1,28 @@
+package client
+
+import "testing"
+
+func TestClassifySource(t *testing.T) {
+	tests := []struct {
+		app  string
+		want Source
+	}{
+		{"", SourceUnknown},
+		{"toggl-go", SourceLibrary},
+		{"toggl-go/1.2", SourceLibrary},
+		{"toggl-gopher", SourceOther},
+		{"TogglDesktop", SourceDesktop},
+		{"Toggl Track for Windows", SourceDesktop},
+		{"TogglButton", SourceExtension},
+		{"Toggl Button - Chrome", SourceExtension},
+		{"Snowball", SourceWeb},
+		{"Toggl Track iOS", SourceMobile},
+		{"Machinery", SourceOther},
+		{"webhooks-bridge", SourceOther},
+	}
+	for _, tt := range tests {
+		if got := ClassifySource(tt.app); got != tt.want {
+			t.Errorf("ClassifySource(%q) = %q, want %q", tt.app, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Tag represent a toggl tag
+type Tag struct {
+	ID          int       `json:"id"`
+	WorkspaceID int       `json:"wid"`
+	Name        string    `json:"name"`
+	At          time.Time `json:"at,omitzero"`
+}
+
+// TagsService handles tag endpoints
+type TagsService struct {
+	client *Client
+}
+
+// List returns tags of the workspace.
+// Results are cached as described by WithLookupCache.
+func (s *TagsService) List(ctx context.Context, workspaceID int) ([]Tag, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if tags, ok := s.client.lookups.tags(workspaceID); ok && callSettingsFrom(ctx).validators == nil {
+		return tags, nil
+	}
+
+	var tags []Tag
+	err = s.client.getCached(ctx, fmt.Sprintf("%s/%d/tags", endpointWorkspaces, workspaceID), &tags)
+	if err != nil {
+		return nil, err
+	}
+	s.client.lookups.setTags(workspaceID, tags)
+	return tags, nil
+}
+
+// Create creates the tag in its workspace, the default workspace when WorkspaceID is 0.
+func (s *TagsService) Create(ctx context.Context, t *Tag) (*Tag, error) {
+	in := *t
+	workspaceID, err := s.client.workspace(in.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	in.WorkspaceID = workspaceID
+	body := struct {
+		Data *Tag `json:"data"`
+	}{}
+	err = s.client.do(ctx, "POST", endpointTags, struct {
+		Tag *Tag `json:"tag"`
+	}{&in}, &body)
+	if err != nil {
+		return nil, err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return body.Data, nil
+}
+
+// Update updates the tag, which must have an ID. Its workspace is the default workspace when WorkspaceID is 0.
+func (s *TagsService) Update(ctx context.Context, t *Tag) (*Tag, error) {
+	if t.ID == 0 {
+		return nil, ErrIdUnset
+	}
+	in := *t
+	workspaceID, err := s.client.workspace(in.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	in.WorkspaceID = workspaceID
+	body := struct {
+		Data *Tag `json:"data"`
+	}{}
+	err = s.client.do(ctx, "PUT", fmt.Sprintf("%s/%d", endpointTags, in.ID), struct {
+		Tag *Tag `json:"tag"`
+	}{&in}, &body)
+	if err != nil {
+		return nil, err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return body.Data, nil
+}
+
+// Delete deletes the tag of the workspace.
+func (s *TagsService) Delete(ctx context.Context, workspaceID, id int) error {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return err
+	}
+	err = s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", endpointTags, id), nil, nil)
+	if err != nil {
+		return err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	return nil
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// TagGroup is a set of mutually exclusive tags, e.g. the tags matching "billable-type:*"
+type TagGroup struct {
+	Name string
+	// Pattern matches the tags of the group, see path.Match. Names are compared folded, see FoldName.
+	Pattern string
+	// Required asks for exactly one tag of the group instead of at most one
+	Required bool
+}
+
+// Taxonomy is the set of tags time entries of a workspace may have
+type Taxonomy struct {
+	// Allowed are the tags entries may have. Any tag is allowed when it is empty.
+	// Tags of Groups are allowed as well.
+	Allowed []string
+	Groups  []TagGroup
+}
+
+// ViolationKind is the rule a tag breaks
+type ViolationKind string
+
+// Violation kinds
+const (
+	ViolationUnknownTag ViolationKind = "unknown tag"
+	ViolationExclusive  ViolationKind = "exclusive tags"
+	ViolationMissing    ViolationKind = "missing tag"
+)
+
+// TaxonomyViolation is a rule of a Taxonomy the tags of an entry break
+type TaxonomyViolation struct {
+	Kind ViolationKind
+	// Group is the name of the group, empty for unknown tags
+	Group string
+	// Tags are the tags breaking the rule, empty for a missing tag
+	Tags []string
+}
+
+func (v TaxonomyViolation) String() string {
+	switch v.Kind {
+	case ViolationUnknownTag:
+		return fmt.Sprintf("tag %q is not allowed", v.Tags[0])
+	case ViolationExclusive:
+		return fmt.Sprintf("only one tag of %s is allowed, got %s", v.Group, strings.Join(v.Tags, ", "))
+	}
+	return fmt.Sprintf("a tag of %s is required", v.Group)
+}
+
+// TaxonomyError is returned by writes of time entries breaking the taxonomy of their workspace.
+// Nothing is sent to toggl.
+type TaxonomyError struct {
+	WorkspaceID int
+	Description string
+	Violations  []TaxonomyViolation
+}
+
+func (e *TaxonomyError) Error() string {
+	s := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		s[i] = v.String()
+	}
+	return fmt.Sprintf("time entry %q breaks the tag taxonomy of workspace %d: %s", e.Description, e.WorkspaceID, strings.Join(s, "; "))
+}
+
+func (g *TagGroup) match(tag string) bool {
+	ok, _ := path.Match(FoldName(g.Pattern), FoldName(tag))
+	return ok
+}
+
+// Check returns the violations of the tags, none when they follow the taxonomy.
+func (t *Taxonomy) Check(tags []string) []TaxonomyViolation {
+	var violations []TaxonomyViolation
+	grouped := make([][]string, len(t.Groups))
+	for _, tag := range tags {
+		known := len(t.Allowed) == 0
+		for i := range t.Groups {
+			if t.Groups[i].match(tag) {
+				grouped[i] = append(grouped[i], tag)
+				known = true
+			}
+		}
+		for _, allowed := range t.Allowed {
+			if EqualNames(allowed, tag) {
+				known = true
+			}
+		}
+		if !known {
+			violations = append(violations, TaxonomyViolation{Kind: ViolationUnknownTag, Tags: []string{tag}})
+		}
+	}
+	for i, g := range t.Groups {
+		switch {
+		case len(grouped[i]) > 1:
+			violations = append(violations, TaxonomyViolation{Kind: ViolationExclusive, Group: g.Name, Tags: grouped[i]})
+		case len(grouped[i]) == 0 && g.Required:
+			violations = append(violations, TaxonomyViolation{Kind: ViolationMissing, Group: g.Name})
+		}
+	}
+	return violations
+}
+
+// WithTaxonomy enforces the taxonomy on the time entries the client creates, starts and updates
+// in the workspace, or in every workspace without taxonomy of its own when workspaceID is 0.
+// Updates without tags keep the current tags and are not checked.
+func WithTaxonomy(workspaceID int, t *Taxonomy) Option {
+	return func(c *Client) error {
+		if c.taxonomies == nil {
+			c.taxonomies = map[int]*Taxonomy{}
+		}
+		c.taxonomies[workspaceID] = t
+		return nil
+	}
+}
+
+// checkTaxonomy returns a *TaxonomyError when the entry breaks the taxonomy of its workspace.
+func (c *Client) checkTaxonomy(entry *TimeEntry) error {
+	if len(c.taxonomies) == 0 {
+		return nil
+	}
+	workspaceID := entry.WorkspaceID
+	if workspaceID == 0 {
+		workspaceID = c.DefaultWorkspace()
+	}
+	t, ok := c.taxonomies[workspaceID]
+	if !ok {
+		t, ok = c.taxonomies[0]
+	}
+	if !ok {
+		return nil
+	}
+	if violations := t.Check(entry.Tags); len(violations) > 0 {
+		return &TaxonomyError{WorkspaceID: workspaceID, Description: entry.Description, Violations: violations}
+	}
+	return nil
+}
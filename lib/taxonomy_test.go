@@ -0,0 +1,33 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestTaxonomyWrites(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	taxonomy := &client.Taxonomy{Groups: []client.TagGroup{{Name: "type", Pattern: "type:*", Required: true}}}
+	c, err := server.NewClient(client.WithDefaultWorkspace(1), client.WithTaxonomy(0, taxonomy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if _, err := c.TimeEntries.Create(ctx, &client.TimeEntry{Description: "untyped"}); err == nil {
+		t.Error("Create without a required tag succeeded")
+	} else if _, ok := err.(*client.TaxonomyError); !ok {
+		t.Errorf("Create error = %v, want a *TaxonomyError", err)
+	}
+	// Without tags the update keeps the current tags of the entry
+	if _, err := c.TimeEntries.Update(ctx, &client.TimeEntry{ID: 5000, Description: "renamed"}); err != nil {
+		t.Errorf("Update without tags: %v", err)
+	}
+	if _, err := c.TimeEntries.Update(ctx, &client.TimeEntry{ID: 5000, Tags: []string{"dev"}}); err == nil {
+		t.Error("Update with tags breaking the taxonomy succeeded")
+	}
+}
@@ -0,0 +1,291 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// TimeEntry represent a toggl time entry.
+// Start and Stop are decoded from RFC3339 timestamps and Duration from seconds.
+// A running entry has no Stop and no Duration, see IsRunning. A finished entry may be given
+// by Start and Duration only.
+type TimeEntry struct {
+	ID          int64         `json:"id,omitempty"`
+	GUID        string        `json:"guid,omitempty"`
+	WorkspaceID int           `json:"wid,omitempty"`
+	ProjectID   int           `json:"pid,omitempty"`
+	TaskID      int           `json:"tid,omitempty"`
+	UserID      int           `json:"uid,omitempty"`
+	Billable    bool          `json:"billable"`
+	Start       time.Time     `json:"-"`
+	Stop        time.Time     `json:"-"`
+	Duration    time.Duration `json:"-"`
+	Description string        `json:"description"`
+	Tags        []string      `json:"tags,omitempty"`
+	DurOnly     bool          `json:"duronly,omitempty"`
+	CreatedWith string        `json:"created_with,omitempty"`
+	At          time.Time     `json:"at,omitzero"`
+}
+
+// entryTimes is the wire format of start, stop and duration shared by time entry payloads.
+// Toggl encodes the duration of a running entry as the negative start in unix seconds.
+type entryTimes struct {
+	Start    string `json:"start,omitempty"`
+	Stop     string `json:"stop,omitempty"`
+	Duration int64  `json:"duration"`
+}
+
+func (t entryTimes) decode() (start, stop time.Time, duration time.Duration, err error) {
+	if t.Start != "" {
+		start, err = time.Parse(time.RFC3339, t.Start)
+		if err != nil {
+			return
+		}
+	}
+	if t.Stop != "" {
+		stop, err = time.Parse(time.RFC3339, t.Stop)
+		if err != nil {
+			return
+		}
+	}
+	if t.Duration > 0 {
+		duration = time.Duration(t.Duration) * time.Second
+	}
+	return
+}
+
+func encodeEntryTimes(start, stop time.Time, duration time.Duration) entryTimes {
+	t := entryTimes{Duration: int64(duration / time.Second)}
+	if !start.IsZero() {
+		t.Start = start.Format(time.RFC3339)
+	}
+	if !stop.IsZero() {
+		t.Stop = stop.Format(time.RFC3339)
+	} else if !start.IsZero() && duration <= 0 {
+		t.Duration = -start.Unix()
+	}
+	return t
+}
+
+// IsRunning reports whether the timer of the entry is running.
+// An entry with Start and a positive Duration but no Stop is finished.
+func (e *TimeEntry) IsRunning() bool {
+	return !e.Start.IsZero() && e.Stop.IsZero() && e.Duration <= 0
+}
+
+// Elapsed returns the tracked time of the entry, up to now for a running entry.
+func (e *TimeEntry) Elapsed(now time.Time) time.Duration {
+	if e.IsRunning() {
+		return now.Sub(e.Start)
+	}
+	return e.Duration
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e TimeEntry) MarshalJSON() ([]byte, error) {
+	type alias TimeEntry
+	return json.Marshal(struct {
+		alias
+		entryTimes
+	}{alias(e), encodeEntryTimes(e.Start, e.Stop, e.Duration)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *TimeEntry) UnmarshalJSON(b []byte) (err error) {
+	type alias TimeEntry
+	aux := struct {
+		*alias
+		entryTimes
+	}{alias: (*alias)(e)}
+	if err = json.Unmarshal(b, &aux); err != nil {
+		return
+	}
+	e.Start, e.Stop, e.Duration, err = aux.decode()
+	return
+}
+
+// createdWith is sent as created_with of entries made by this library
+const createdWith = "toggl-go"
+
+// TimeEntriesService handles time entry endpoints
+type TimeEntriesService struct {
+	client *Client
+}
+
+type timeEntryRequest struct {
+	TimeEntry *TimeEntry `json:"time_entry"`
+}
+
+type timeEntryResponse struct {
+	Data *TimeEntry `json:"data"`
+}
+
+func (s *TimeEntriesService) send(ctx context.Context, method, rawurl string, entry *TimeEntry) (*TimeEntry, error) {
+	var in interface{}
+	if entry != nil {
+		e := *entry
+		if e.CreatedWith == "" {
+			e.CreatedWith = createdWith
+		}
+		in = timeEntryRequest{&e}
+	}
+	body := timeEntryResponse{}
+	if err := s.client.do(ctx, method, rawurl, in, &body); err != nil {
+		return nil, err
+	}
+	return body.Data, nil
+}
+
+// Get returns the time entry.
+func (s *TimeEntriesService) Get(ctx context.Context, id int64) (*TimeEntry, error) {
+	return s.send(ctx, "GET", fmt.Sprintf("%s/%d", endpointTimeEntries, id), nil)
+}
+
+// Current returns the running time entry, or nil when no timer is running.
+func (s *TimeEntriesService) Current(ctx context.Context) (*TimeEntry, error) {
+	return s.send(ctx, "GET", endpointTimeEntries+"/current", nil)
+}
+
+// List returns time entries started between start and end.
+// Toggl returns the entries of the last 9 days when both are zero.
+func (s *TimeEntriesService) List(ctx context.Context, start, end time.Time) ([]TimeEntry, error) {
+	v := url.Values{}
+	if !start.IsZero() {
+		v.Set("start_date", start.Format(time.RFC3339))
+	}
+	if !end.IsZero() {
+		v.Set("end_date", end.Format(time.RFC3339))
+	}
+	rawurl := endpointTimeEntries
+	if len(v) > 0 {
+		rawurl += "?" + v.Encode()
+	}
+	var entries []TimeEntry
+	err := s.client.get(ctx, rawurl, &entries)
+	return entries, err
+}
+
+// Create creates a time entry. It runs when Stop is zero.
+func (s *TimeEntriesService) Create(ctx context.Context, entry *TimeEntry) (*TimeEntry, error) {
+	if err := s.client.checkTaxonomy(entry); err != nil {
+		return nil, err
+	}
+	return s.send(ctx, "POST", endpointTimeEntries, entry)
+}
+
+// Start starts a new running time entry. Start and Stop of entry are ignored.
+// Toggl stops the entry running before, if any, see WithReconciliation.
+func (s *TimeEntriesService) Start(ctx context.Context, entry *TimeEntry) (*TimeEntry, error) {
+	if err := s.client.checkTaxonomy(entry); err != nil {
+		return nil, err
+	}
+	var running *TimeEntry
+	if s.client.reconcile {
+		var err error
+		if running, err = s.Current(ctx); err != nil {
+			return nil, err
+		}
+	}
+	started, err := s.send(ctx, "POST", endpointStartTime, entry)
+	if err != nil || running == nil || started == nil {
+		return started, err
+	}
+	return s.reconcile(ctx, running, started)
+}
+
+// Stop stops the running time entry.
+func (s *TimeEntriesService) Stop(ctx context.Context, id int64) (*TimeEntry, error) {
+	return s.send(ctx, "PUT", fmt.Sprintf("%s/%d/stop", endpointTimeEntries, id), nil)
+}
+
+// Update replaces the time entry with the given one.
+// Toggl keeps the current tags when Tags is empty, so the taxonomy is only checked on given tags.
+func (s *TimeEntriesService) Update(ctx context.Context, entry *TimeEntry) (*TimeEntry, error) {
+	if entry.ID == 0 {
+		return nil, ErrIdUnset
+	}
+	if len(entry.Tags) > 0 {
+		if err := s.client.checkTaxonomy(entry); err != nil {
+			return nil, err
+		}
+	}
+	return s.send(ctx, "PUT", fmt.Sprintf("%s/%d", endpointTimeEntries, entry.ID), entry)
+}
+
+// Delete deletes the time entry.
+func (s *TimeEntriesService) Delete(ctx context.Context, id int64) error {
+	return s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d", endpointTimeEntries, id), nil, nil)
+}
+
+// StartNow starts a running entry with the description, project and tags.
+// The entry goes to the default workspace of the client when projectID is 0.
+func (s *TimeEntriesService) StartNow(ctx context.Context, description string, projectID int, tags []string) (*TimeEntry, error) {
+	entry := &TimeEntry{
+		Description: description,
+		ProjectID:   projectID,
+		Tags:        tags,
+	}
+	if projectID == 0 {
+		workspaceID, err := s.client.workspace(0)
+		if err != nil {
+			return nil, err
+		}
+		entry.WorkspaceID = workspaceID
+	}
+	return s.Start(ctx, entry)
+}
+
+// StopCurrent stops the running entry and returns it.
+// It returns ErrNoRunningEntry when no timer is running.
+func (s *TimeEntriesService) StopCurrent(ctx context.Context) (*TimeEntry, error) {
+	current, err := s.Current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, ErrNoRunningEntry
+	}
+	return s.Stop(ctx, current.ID)
+}
+
+// Continue starts a new running entry with the description, project, task, tags and billable of the entry.
+func (s *TimeEntriesService) Continue(ctx context.Context, id int64) (*TimeEntry, error) {
+	entry, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.continueEntry(ctx, entry)
+}
+
+// ContinueLast continues the entry started last in the past 9 days.
+// It returns ErrNoEntry when there is none.
+func (s *TimeEntriesService) ContinueLast(ctx context.Context) (*TimeEntry, error) {
+	entries, err := s.List(ctx, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	var last *TimeEntry
+	for i := range entries {
+		if last == nil || entries[i].Start.After(last.Start) {
+			last = &entries[i]
+		}
+	}
+	if last == nil {
+		return nil, ErrNoEntry
+	}
+	return s.continueEntry(ctx, last)
+}
+
+func (s *TimeEntriesService) continueEntry(ctx context.Context, entry *TimeEntry) (*TimeEntry, error) {
+	return s.Start(ctx, &TimeEntry{
+		WorkspaceID: entry.WorkspaceID,
+		ProjectID:   entry.ProjectID,
+		TaskID:      entry.TaskID,
+		Billable:    entry.Billable,
+		Description: entry.Description,
+		Tags:        entry.Tags,
+	})
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeEntryRunning(t *testing.T) {
+	start := time.Date(2016, 6, 8, 3, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		entry    TimeEntry
+		running  bool
+		duration int64
+	}{
+		{"start only", TimeEntry{Start: start}, true, -start.Unix()},
+		{"start and duration", TimeEntry{Start: start, Duration: 2 * time.Hour}, false, 7200},
+		{"start and stop", TimeEntry{Start: start, Stop: start.Add(time.Hour), Duration: time.Hour}, false, 3600},
+	}
+	for _, tt := range tests {
+		if got := tt.entry.IsRunning(); got != tt.running {
+			t.Errorf("%s: IsRunning() = %v, want %v", tt.name, got, tt.running)
+		}
+		b, err := json.Marshal(tt.entry)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var wire struct {
+			Duration int64 `json:"duration"`
+		}
+		if err := json.Unmarshal(b, &wire); err != nil {
+			t.Fatal(err)
+		}
+		if wire.Duration != tt.duration {
+			t.Errorf("%s: duration = %d, want %d", tt.name, wire.Duration, tt.duration)
+		}
+	}
+}
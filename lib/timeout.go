@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultReportTimeout is the timeout of report endpoints when WithTimeout is given without WithReportTimeout.
+// Reports of big workspaces take tens of seconds to generate.
+const DefaultReportTimeout = 2 * time.Minute
+
+// WithTimeout bounds every request of the client, including reading its response, even when the
+// context of the call has no deadline. Report endpoints use the longer timeout of WithReportTimeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		c.timeout = timeout
+		return nil
+	}
+}
+
+// WithReportTimeout bounds requests of the report endpoints, DefaultReportTimeout when only WithTimeout is given.
+func WithReportTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		c.reportTimeout = timeout
+		return nil
+	}
+}
+
+// CallTimeout bounds each request of the call, instead of the timeouts of the client.
+func CallTimeout(timeout time.Duration) CallOption {
+	return func(s *callSettings) {
+		s.timeout = timeout
+	}
+}
+
+// CallDeadline makes the requests of the call fail after t, on top of the timeouts of the client.
+func CallDeadline(t time.Time) CallOption {
+	return func(s *callSettings) {
+		s.deadline = t
+	}
+}
+
+func isReport(req *http.Request) bool {
+	return strings.Contains(req.URL.Path, "/reports/api/")
+}
+
+// withTimeout returns the request bound by the timeout and deadline applying to it, and the function releasing them.
+func (c *Client) withTimeout(req *http.Request) (*http.Request, context.CancelFunc) {
+	ctx := req.Context()
+	settings := callSettingsFrom(ctx)
+	timeout := settings.timeout
+	if timeout == 0 {
+		timeout = c.timeout
+		if isReport(req) && c.reportTimeout != 0 {
+			timeout = c.reportTimeout
+		} else if isReport(req) && c.timeout != 0 && c.timeout < DefaultReportTimeout {
+			timeout = DefaultReportTimeout
+		}
+	}
+	if timeout == 0 && settings.deadline.IsZero() {
+		return req, func() {}
+	}
+
+	cancel := func() {}
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	if !settings.deadline.IsZero() {
+		var cancelDeadline context.CancelFunc
+		ctx, cancelDeadline = context.WithDeadline(ctx, settings.deadline)
+		cancelTimeout := cancel
+		cancel = func() {
+			cancelDeadline()
+			cancelTimeout()
+		}
+	}
+	return req.WithContext(ctx), cancel
+}
@@ -0,0 +1,64 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+// slowTransport answers after delay, unless the request is cancelled first.
+type slowTransport struct {
+	delay time.Duration
+}
+
+func (t slowTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case <-time.After(t.delay):
+		return http.DefaultTransport.RoundTrip(req)
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+}
+
+func TestTimeouts(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	slow := client.WithHTTPClient(&http.Client{Transport: slowTransport{delay: 100 * time.Millisecond}})
+	ctx := context.Background()
+	report := func(c *client.Client, ctx context.Context) error {
+		_, err := c.Reports.Detailed(ctx, &client.ReportParams{WorkspaceID: 1})
+		return err
+	}
+
+	for _, tc := range []struct {
+		name       string
+		opts       []client.Option
+		ctx        context.Context
+		call, rept bool
+	}{
+		{"report timeout", []client.Option{client.WithTimeout(20 * time.Millisecond), client.WithReportTimeout(time.Second)}, ctx, false, true},
+		{"default report timeout", []client.Option{client.WithTimeout(20 * time.Millisecond)}, ctx, false, true},
+		{"short report timeout", []client.Option{client.WithTimeout(time.Second), client.WithReportTimeout(20 * time.Millisecond)}, ctx, true, false},
+		{"call timeout", []client.Option{client.WithTimeout(20 * time.Millisecond)}, client.WithCallOptions(ctx, client.CallTimeout(time.Second)), true, true},
+		{"call deadline", []client.Option{client.WithTimeout(time.Second)}, client.WithCallOptions(ctx, client.CallDeadline(time.Now().Add(20*time.Millisecond))), false, false},
+		{"no timeout", nil, ctx, true, true},
+	} {
+		c, err := server.NewClient(append(tc.opts, slow)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = c.Me(tc.ctx)
+		if tc.call != (err == nil) || err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("%s: Me() = %v, want success %v", tc.name, err, tc.call)
+		}
+		err = report(c, tc.ctx)
+		if tc.rept != (err == nil) || err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("%s: Detailed() = %v, want success %v", tc.name, err, tc.rept)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package toggl
+
+import (
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+)
+
+func tags(t []string) []string {
+	if len(t) == 0 {
+		return nil
+	}
+	return append([]string(nil), t...)
+}
+
+// stopOf returns the stop of a wire entry, which some payloads leave out of stopped entries.
+func stopOf(start, stop time.Time, duration time.Duration) time.Time {
+	if stop.IsZero() && duration > 0 {
+		return start.Add(duration)
+	}
+	return stop
+}
+
+// FromV8TimeEntry converts a time entry of the API v8.
+func FromV8TimeEntry(e *client.TimeEntry) TimeEntry {
+	return TimeEntry{
+		ID:          e.ID,
+		WorkspaceID: e.WorkspaceID,
+		ProjectID:   e.ProjectID,
+		TaskID:      e.TaskID,
+		UserID:      e.UserID,
+		Description: e.Description,
+		Start:       e.Start,
+		Stop:        stopOf(e.Start, e.Stop, e.Duration),
+		Billable:    e.Billable,
+		Tags:        tags(e.Tags),
+		CreatedWith: e.CreatedWith,
+		UpdatedAt:   e.At,
+	}
+}
+
+// ToV8TimeEntry converts a time entry for the API v8, e.g. to create or update it.
+func ToV8TimeEntry(e *TimeEntry) *client.TimeEntry {
+	out := &client.TimeEntry{
+		ID:          e.ID,
+		WorkspaceID: e.WorkspaceID,
+		ProjectID:   e.ProjectID,
+		TaskID:      e.TaskID,
+		UserID:      e.UserID,
+		Description: e.Description,
+		Start:       e.Start,
+		Stop:        e.Stop,
+		Billable:    e.Billable,
+		Tags:        tags(e.Tags),
+		CreatedWith: e.CreatedWith,
+		At:          e.UpdatedAt,
+	}
+	if !e.Running() {
+		out.Duration = e.Stop.Sub(e.Start)
+	}
+	return out
+}
+
+// FromV9TimeEntry converts a time entry of the API v9, as delivered by webhooks.
+func FromV9TimeEntry(e *client.WebhookTimeEntry) TimeEntry {
+	return TimeEntry{
+		ID:          e.ID,
+		WorkspaceID: e.WorkspaceID,
+		ProjectID:   e.ProjectID,
+		TaskID:      e.TaskID,
+		UserID:      e.UserID,
+		Description: e.Description,
+		Start:       e.Start,
+		Stop:        stopOf(e.Start, e.Stop, e.Duration),
+		Billable:    e.Billable,
+		Tags:        tags(e.Tags),
+		UpdatedAt:   e.At,
+	}
+}
+
+// FromReportsV2TimeEntry converts a time entry of the detailed report of the reports API v2,
+// which has no workspace: it is the one the report was run on.
+func FromReportsV2TimeEntry(workspaceID int, e *client.ReportTimeEntry) TimeEntry {
+	return TimeEntry{
+		ID:          e.ID,
+		WorkspaceID: workspaceID,
+		ProjectID:   e.ProjectID,
+		TaskID:      e.TaskID,
+		UserID:      e.UserID,
+		Description: e.Description,
+		Start:       e.Start,
+		Stop:        stopOf(e.Start, e.End, time.Duration(e.Dur)*time.Millisecond),
+		Billable:    e.IsBillable,
+		Tags:        tags(e.Tags),
+		UpdatedAt:   e.Updated,
+	}
+}
+
+// FromReportsV3Row converts a row of the detailed search of the reports API v3
+// into one time entry per entry of the row. Tags are left out, rows only have their IDs.
+func FromReportsV3Row(workspaceID int, row *client.ReportsV3Row) []TimeEntry {
+	entries := make([]TimeEntry, 0, len(row.TimeEntries))
+	for _, e := range row.TimeEntries {
+		entries = append(entries, TimeEntry{
+			ID:          e.ID,
+			WorkspaceID: workspaceID,
+			ProjectID:   row.ProjectID,
+			TaskID:      row.TaskID,
+			UserID:      row.UserID,
+			Description: row.Description,
+			Start:       e.Start,
+			Stop:        stopOf(e.Start, e.Stop, time.Duration(e.Seconds)*time.Second),
+			Billable:    row.Billable,
+			UpdatedAt:   e.At,
+		})
+	}
+	return entries
+}
+
+// FromV8Project converts a project of the API v8.
+func FromV8Project(p *client.Project) Project {
+	return Project{
+		ID:          p.ID,
+		WorkspaceID: p.WorkspaceID,
+		ClientID:    p.ClientID,
+		Name:        p.Name,
+		Billable:    p.Billable,
+		Private:     p.IsPrivate,
+		Archived:    !p.Active,
+		Color:       p.Color,
+		UpdatedAt:   p.At,
+	}
+}
+
+// ToV8Project converts a project for the API v8.
+func ToV8Project(p *Project) *client.Project {
+	return &client.Project{
+		ID:          p.ID,
+		WorkspaceID: p.WorkspaceID,
+		ClientID:    p.ClientID,
+		Name:        p.Name,
+		Billable:    p.Billable,
+		IsPrivate:   p.Private,
+		Active:      !p.Archived,
+		Color:       p.Color,
+		At:          p.UpdatedAt,
+	}
+}
+
+// FromV8Projects converts projects of the API v8.
+func FromV8Projects(projects []client.Project) []Project {
+	out := make([]Project, len(projects))
+	for i := range projects {
+		out[i] = FromV8Project(&projects[i])
+	}
+	return out
+}
+
+// FromV8TimeEntries converts time entries of the API v8.
+func FromV8TimeEntries(entries []client.TimeEntry) []TimeEntry {
+	out := make([]TimeEntry, len(entries))
+	for i := range entries {
+		out[i] = FromV8TimeEntry(&entries[i])
+	}
+	return out
+}
@@ -0,0 +1,114 @@
+package toggl
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+)
+
+var start = time.Date(2016, 6, 9, 1, 0, 0, 0, time.UTC)
+
+func decode(t *testing.T, b string, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal([]byte(b), v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestV8TimeEntryRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name, wire string
+		stop       time.Time
+		duration   int64
+	}{
+		{"running", `{"id":5000,"wid":1,"pid":100,"start":"2016-06-09T01:00:00Z","duration":-1465434000,"description":"Writing","tags":["dev"],"created_with":"toggl-go"}`,
+			time.Time{}, -start.Unix()},
+		{"stopped", `{"id":5000,"wid":1,"pid":100,"start":"2016-06-09T01:00:00Z","stop":"2016-06-09T02:00:00Z","duration":3600,"description":"Writing","tags":["dev"],"created_with":"toggl-go"}`,
+			start.Add(time.Hour), 3600},
+		{"start and duration", `{"id":5000,"wid":1,"pid":100,"start":"2016-06-09T01:00:00Z","duration":5400,"description":"Writing","tags":["dev"],"created_with":"toggl-go"}`,
+			start.Add(90 * time.Minute), 5400},
+	} {
+		var v8 client.TimeEntry
+		decode(t, tc.wire, &v8)
+		e := FromV8TimeEntry(&v8)
+		want := TimeEntry{ID: 5000, WorkspaceID: 1, ProjectID: 100, Description: "Writing", Start: start, Stop: tc.stop, Tags: []string{"dev"}, CreatedWith: "toggl-go"}
+		if !e.Start.Equal(want.Start) || !e.Stop.Equal(want.Stop) {
+			t.Errorf("%s: FromV8TimeEntry() runs %v to %v, want %v to %v", tc.name, e.Start, e.Stop, want.Start, want.Stop)
+		}
+		e.Start, e.Stop, want.Start, want.Stop = time.Time{}, time.Time{}, time.Time{}, time.Time{}
+		if !reflect.DeepEqual(e, want) {
+			t.Errorf("%s: FromV8TimeEntry() = %+v, want %+v", tc.name, e, want)
+		}
+		if e := FromV8TimeEntry(&v8); e.Running() != tc.stop.IsZero() {
+			t.Errorf("%s: Running() = %v", tc.name, e.Running())
+		}
+
+		e = FromV8TimeEntry(&v8)
+		b, err := json.Marshal(ToV8TimeEntry(&e))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var wire struct {
+			Duration int64      `json:"duration"`
+			Stop     *time.Time `json:"stop"`
+		}
+		decode(t, string(b), &wire)
+		if wire.Duration != tc.duration || (wire.Stop == nil) != tc.stop.IsZero() {
+			t.Errorf("%s: ToV8TimeEntry() sends duration %d and stop %v, want %d and %v", tc.name, wire.Duration, wire.Stop, tc.duration, tc.stop)
+		}
+		var back client.TimeEntry
+		decode(t, string(b), &back)
+		if again := FromV8TimeEntry(&back); !again.Stop.Equal(e.Stop) || !again.Start.Equal(e.Start) || again.Description != e.Description {
+			t.Errorf("%s: round trip = %+v, want %+v", tc.name, again, e)
+		}
+	}
+}
+
+func TestFromReportsV2TimeEntry(t *testing.T) {
+	var row client.ReportTimeEntry
+	decode(t, `{"id":5001,"pid":100,"uid":1000,"description":"Landing page","start":"2016-06-06T10:00:00+09:00","end":"2016-06-06T11:00:00+09:00","dur":3600000,"is_billable":true,"tags":["dev"]}`, &row)
+	e := FromReportsV2TimeEntry(1, &row)
+	if e.WorkspaceID != 1 || e.ID != 5001 || !e.Billable || e.Duration(time.Time{}) != time.Hour || len(e.Tags) != 1 {
+		t.Errorf("FromReportsV2TimeEntry() = %+v", e)
+	}
+
+	// Rows without end stop after dur milliseconds
+	row.End = time.Time{}
+	row.Dur = 90 * 60 * 1000
+	if e := FromReportsV2TimeEntry(1, &row); e.Duration(time.Time{}) != 90*time.Minute {
+		t.Errorf("duration of a row without end = %v, want 1h30m", e.Duration(time.Time{}))
+	}
+}
+
+func TestFromReportsV3Row(t *testing.T) {
+	var row client.ReportsV3Row
+	decode(t, `{"user_id":1000,"project_id":100,"billable":true,"description":"Landing page","tag_ids":[20],
+ "time_entries":[{"id":5001,"seconds":3600,"start":"2016-06-06T01:00:00Z","stop":"2016-06-06T02:00:00Z"},
+  {"id":5003,"seconds":1800,"start":"2016-06-07T01:00:00Z"}]}`, &row)
+	entries := FromReportsV3Row(1, &row)
+	if len(entries) != 2 {
+		t.Fatalf("entries = %+v, want 2", entries)
+	}
+	for i, want := range []time.Duration{time.Hour, 30 * time.Minute} {
+		e := entries[i]
+		if e.Duration(time.Time{}) != want || e.ProjectID != 100 || e.UserID != 1000 || e.Description != "Landing page" || !e.Billable || e.Tags != nil {
+			t.Errorf("entry %d = %+v, want %v of the row", i, e, want)
+		}
+	}
+}
+
+func TestV8ProjectRoundTrip(t *testing.T) {
+	for _, active := range []bool{true, false} {
+		in := client.Project{ID: 100, WorkspaceID: 1, ClientID: 10, Name: "Website", Billable: true, Active: active, Color: "5"}
+		p := FromV8Project(&in)
+		if p.Archived == active {
+			t.Errorf("Archived = %v of a project with Active %v", p.Archived, active)
+		}
+		if out := ToV8Project(&p); !reflect.DeepEqual(*out, in) {
+			t.Errorf("round trip = %+v, want %+v", *out, in)
+		}
+	}
+}
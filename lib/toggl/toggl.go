@@ -0,0 +1,51 @@
+// Package toggl is the domain model of Toggl: time entries and projects as applications use them,
+// independent of the wire formats of the API versions. Package client decodes the API into its own
+// models; converters of this package, named after the API they read, turn those into the domain types.
+// When an API version changes, only its converters change.
+package toggl
+
+import "time"
+
+// TimeEntry is tracked time. A running entry has no Stop.
+type TimeEntry struct {
+	ID          int64
+	WorkspaceID int
+	ProjectID   int
+	TaskID      int
+	UserID      int
+	Description string
+	Start       time.Time
+	Stop        time.Time
+	Billable    bool
+	Tags        []string
+	// CreatedWith is the application the entry was created with, when the API tells it
+	CreatedWith string
+	UpdatedAt   time.Time
+}
+
+// Running reports whether the timer of the entry is running.
+func (e *TimeEntry) Running() bool {
+	return !e.Start.IsZero() && e.Stop.IsZero()
+}
+
+// Duration returns the tracked time of the entry, up to now for a running entry.
+func (e *TimeEntry) Duration(now time.Time) time.Duration {
+	if e.Running() {
+		return now.Sub(e.Start)
+	}
+	return e.Stop.Sub(e.Start)
+}
+
+// Project is a project of a workspace
+type Project struct {
+	ID          int
+	WorkspaceID int
+	// ClientID is 0 for a project without client
+	ClientID  int
+	Name      string
+	Billable  bool
+	Private   bool
+	Archived  bool
+	Color     string
+	UpdatedAt time.Time
+}
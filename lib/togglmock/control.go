@@ -0,0 +1,90 @@
+package togglmock
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// ControlPrefix is the path the control API is served under by Handler
+const ControlPrefix = "/_togglmock"
+
+// ControlRequest is a received request as listed by the control API
+type ControlRequest struct {
+	Method string              `json:"method"`
+	Path   string              `json:"path"`
+	Query  map[string][]string `json:"query,omitempty"`
+	Header map[string][]string `json:"header,omitempty"`
+	Body   string              `json:"body,omitempty"`
+}
+
+// Handler returns the handler of fake API and control API, for serving the Server over the network.
+// The control API under ControlPrefix is:
+//
+//	GET    /_togglmock/requests  received requests, filtered by method and path query parameters
+//	DELETE /_togglmock/requests  forget received requests and queued faults, see Reset
+//	POST   /_togglmock/seed      load a Seed document
+//	GET    /_togglmock/health    200 when the server is up
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ControlPrefix+"/requests", s.serveRequests)
+	mux.HandleFunc(ControlPrefix+"/seed", s.serveSeed)
+	mux.HandleFunc(ControlPrefix+"/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.Handle("/", s)
+	return mux
+}
+
+func controlError(w http.ResponseWriter, status int, message string) {
+	write(w, Error(status, message))
+}
+
+func (s *Server) serveRequests(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		requests := s.Requests()
+		if method, path := r.URL.Query().Get("method"), r.URL.Query().Get("path"); method != "" || path != "" {
+			var segments []string
+			if path != "" {
+				segments = strings.Split(strings.Trim(path, "/"), "/")
+			}
+			filtered := requests[:0]
+			for _, req := range requests {
+				if (method == "" || req.Method == method) && (segments == nil || match(segments, req.Path)) {
+					filtered = append(filtered, req)
+				}
+			}
+			requests = filtered
+		}
+		out := make([]ControlRequest, 0, len(requests))
+		for _, req := range requests {
+			out = append(out, ControlRequest{
+				Method: req.Method,
+				Path:   req.Path,
+				Query:  req.Query,
+				Header: req.Header,
+				Body:   string(req.Body),
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(out)
+	case "DELETE":
+		s.Reset()
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		controlError(w, http.StatusMethodNotAllowed, "use GET or DELETE")
+	}
+}
+
+func (s *Server) serveSeed(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		controlError(w, http.StatusMethodNotAllowed, "use POST")
+		return
+	}
+	if err := s.LoadSeed(r.Body); err != nil {
+		controlError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,176 @@
+package togglmock
+
+// Canned responses served for the endpoints supported by the client.
+// IDs are consistent across fixtures: workspace 1, clients 10 and 11, projects 100 and 101.
+const (
+	FixtureMe = `{"since":1464000000,"data":{
+ "id":1000,"api_token":"test-token","default_wid":1,"email":"user@example.com",
+ "fullname":"Test User","timezone":"Asia/Tokyo","at":"2016-06-01T09:00:00+00:00",
+ "workspaces":[{"id":1,"name":"Test Workspace","admin":true,"at":"2016-06-01T09:00:00+00:00"}]
+}}`
+
+	FixtureWorkspaces = `[
+ {"id":1,"name":"Test Workspace","premium":false,"admin":true,"at":"2016-06-01T09:00:00+00:00"}
+]`
+
+	FixtureWorkspace = `{"data":
+ {"id":1,"name":"Test Workspace","premium":false,"admin":true,"default_hourly_rate":50,"default_currency":"USD",
+  "only_admins_may_create_projects":false,"only_admins_see_billable_rates":true,"only_admins_see_team_dashboard":true,
+  "rounding":1,"rounding_minutes":0,"at":"2016-06-01T09:00:00+00:00"}
+}`
+
+	FixtureClients = `[
+ {"id":10,"wid":1,"name":"Acme","notes":"","at":"2016-06-01T09:00:00+00:00"},
+ {"id":11,"wid":1,"name":"Globex","notes":"","at":"2016-06-02T09:00:00+00:00"}
+]`
+
+	FixtureClient = `{"data":{"id":12,"wid":1,"name":"Initech","notes":"","at":"2016-06-10T09:00:00+00:00"}}`
+
+	FixtureProjects = `[
+ {"id":100,"wid":1,"cid":10,"name":"Website","billable":true,"is_private":false,"active":true,"color":"5","at":"2016-06-01T09:00:00+00:00"},
+ {"id":101,"wid":1,"cid":11,"name":"Support","billable":false,"is_private":false,"active":true,"color":"3","at":"2016-06-03T09:00:00+00:00"}
+]`
+
+	FixtureProject = `{"data":{"id":102,"wid":1,"cid":10,"name":"Research","billable":false,"is_private":true,"active":true,"color":"7","at":"2016-06-10T09:00:00+00:00"}}`
+
+	FixtureTask = `{"data":{"id":300,"wid":1,"pid":102,"uid":1000,"name":"Kickoff","estimated_seconds":7200,"active":true,"at":"2016-06-10T09:00:00+00:00"}}`
+
+	FixtureProjectUser = `{"data":{"id":400,"wid":1,"pid":102,"uid":1000,"manager":true,"rate":80,"at":"2016-06-10T09:00:00+00:00"}}`
+
+	FixtureTags = `[
+ {"id":20,"wid":1,"name":"dev","at":"2016-06-01T09:00:00+00:00"},
+ {"id":21,"wid":1,"name":"meeting","at":"2016-06-01T09:00:00+00:00"}
+]`
+
+	FixtureTag = `{"data":{"id":22,"wid":1,"name":"review","at":"2016-06-10T09:00:00+00:00"}}`
+
+	FixtureGroups = `[
+ {"id":30,"wid":1,"name":"Developers","at":"2016-06-01T09:00:00+00:00"}
+]`
+
+	FixtureGroup = `{"data":{"id":31,"wid":1,"name":"Designers","at":"2016-06-10T09:00:00+00:00"}}`
+
+	FixtureWorkspaceUsers = `[
+ {"id":60,"uid":1000,"wid":1,"admin":true,"active":true,"email":"user@example.com","name":"Test User","group_ids":[30],"at":"2016-06-01T09:00:00+00:00"},
+ {"id":61,"uid":1001,"wid":1,"admin":false,"active":true,"email":"other@example.com","name":"Other User","group_ids":[],"at":"2016-06-01T09:00:00+00:00"}
+]`
+
+	FixtureWorkspaceUser = `{"data":{"id":61,"uid":1001,"wid":1,"admin":false,"active":true,"email":"other@example.com","name":"Other User","group_ids":[30],"at":"2016-06-10T09:00:00+00:00"}}`
+
+	FixtureTimeEntry = `{"data":{
+ "id":5000,"wid":1,"pid":100,"billable":true,"start":"2016-06-09T01:00:00+00:00",
+ "duration":-1465434000,"description":"Writing fixtures","tags":["dev"],"created_with":"toggl-go",
+ "at":"2016-06-09T01:00:00+00:00"
+}}`
+
+	FixtureStoppedTimeEntry = `{"data":{
+ "id":5000,"wid":1,"pid":100,"billable":true,"start":"2016-06-09T01:00:00+00:00","stop":"2016-06-09T02:00:00+00:00",
+ "duration":3600,"description":"Writing fixtures","tags":["dev"],"created_with":"toggl-go",
+ "at":"2016-06-09T02:00:00+00:00"
+}}`
+
+	FixtureTimeEntries = `[
+ {"id":4998,"wid":1,"pid":101,"billable":false,"start":"2016-06-08T01:00:00+00:00","stop":"2016-06-08T01:30:00+00:00",
+  "duration":1800,"description":"Inbox","tags":[],"created_with":"toggl-go","at":"2016-06-08T01:30:00+00:00"},
+ {"id":4999,"wid":1,"pid":100,"billable":true,"start":"2016-06-08T02:00:00+00:00","stop":"2016-06-08T04:00:00+00:00",
+  "duration":7200,"description":"Landing page","tags":["dev"],"created_with":"toggl-go","at":"2016-06-08T04:00:00+00:00"}
+]`
+
+	FixtureWebhookSubscriptions = `[
+ {"subscription_id":7,"workspace_id":1,"user_id":1000,"enabled":true,"description":"entries",
+  "event_filters":[{"entity":"time_entry","action":"*"}],"url_callback":"https://example.com/hook",
+  "secret":"webhook-secret","created_at":"2016-06-01T09:00:00Z"}
+]`
+
+	FixtureWebhookSubscription = `{"subscription_id":7,"workspace_id":1,"user_id":1000,"enabled":true,"description":"entries",
+ "event_filters":[{"entity":"time_entry","action":"*"}],"url_callback":"https://example.com/hook",
+ "secret":"webhook-secret","created_at":"2016-06-01T09:00:00Z"}`
+
+	FixtureReportWeekly = `{"total_grand":7200000,"total_billable":3600000,
+ "total_currencies":[{"currency":"USD","amount":50}],
+ "data":[
+  {"title":{"project":"Website","client":"Acme"},"pid":100,
+   "totals":[3600000,null,null,null,null,null,null,3600000],"details":[]},
+  {"title":{"project":"Support","client":"Globex"},"pid":101,
+   "totals":[null,3600000,null,null,null,null,null,3600000],"details":[]}
+ ]}`
+
+	FixtureReportDetailed = `{"total_grand":7200000,"total_billable":3600000,"total_count":2,"per_page":50,
+ "total_currencies":[{"currency":"USD","amount":50}],
+ "data":[
+  {"id":5001,"pid":100,"tid":null,"uid":1000,"description":"Landing page","start":"2016-06-06T10:00:00+09:00",
+   "end":"2016-06-06T11:00:00+09:00","updated":"2016-06-06T11:00:00+09:00","dur":3600000,"user":"Test User",
+   "use_stop":true,"client":"Acme","project":"Website","task":null,"billable":50,"is_billable":true,"cur":"USD","tags":["dev"]},
+  {"id":5002,"pid":101,"tid":null,"uid":1000,"description":"Inbox","start":"2016-06-07T10:00:00+09:00",
+   "end":"2016-06-07T11:00:00+09:00","updated":"2016-06-07T11:00:00+09:00","dur":3600000,"user":"Test User",
+   "use_stop":true,"client":"Globex","project":"Support","task":null,"billable":0,"is_billable":false,"cur":"USD","tags":[]}
+ ]}`
+
+	FixtureReportSummary = `{"total_grand":7200000,"total_billable":3600000,
+ "total_currencies":[{"currency":"USD","amount":50}],
+ "data":[
+  {"id":100,"title":{"project":"Website","client":"Acme"},"time":3600000,
+   "items":[{"title":{"time_entry":"Landing page"},"time":3600000,"cur":"USD","sum":50,"rate":50}]},
+  {"id":101,"title":{"project":"Support","client":"Globex"},"time":3600000,
+   "items":[{"title":{"time_entry":"Inbox"},"time":3600000,"cur":"USD","sum":0,"rate":0}]}
+ ]}`
+
+	FixtureReportsV3Search = `[
+ {"user_id":1000,"username":"Test User","project_id":100,"task_id":null,"billable":true,"description":"Landing page",
+  "tag_ids":[20],"billable_amount_in_cents":5000,"hourly_rate_in_cents":5000,"currency":"USD","row_number":1,
+  "time_entries":[{"id":5001,"seconds":3600,"start":"2016-06-06T10:00:00+09:00","stop":"2016-06-06T11:00:00+09:00","at":"2016-06-06T11:00:00+09:00"}]},
+ {"user_id":1000,"username":"Test User","project_id":101,"task_id":null,"billable":false,"description":"Inbox",
+  "tag_ids":[],"billable_amount_in_cents":0,"hourly_rate_in_cents":0,"currency":"USD","row_number":2,
+  "time_entries":[{"id":5002,"seconds":3600,"start":"2016-06-07T10:00:00+09:00","stop":"2016-06-07T11:00:00+09:00","at":"2016-06-07T11:00:00+09:00"}]}
+]`
+
+	FixtureReportsV3Summary = `{"groups":[
+ {"id":10,"sub_groups":[{"id":100,"title":"Website","seconds":3600,"rates":[{"billable_seconds":3600,"hourly_rate_in_cents":5000,"currency":"USD"}]}]},
+ {"id":11,"sub_groups":[{"id":101,"title":"Support","seconds":3600}]}
+]}`
+
+	FixtureReportDetailedCSV = "User,Email,Client,Project,Task,Description,Billable,Start date,Start time,End date,End time,Duration,Tags,Amount ()\n" +
+		"Test User,user@example.com,Acme,Website,,Landing page,Yes,2016-06-06,10:00:00,2016-06-06,11:00:00,01:00:00,dev,50.00\n" +
+		"Test User,user@example.com,Globex,Support,,Inbox,No,2016-06-07,10:00:00,2016-06-07,11:00:00,01:00:00,,\n"
+
+	FixtureSavedReport = `{"report_token":"abc123","workspace_id":1,"name":"Acme monthly","report_type":"summary",
+ "public":true,"fixed_daterange":true,
+ "params":{"start_date":"2016-06-01","end_date":"2016-06-30","client_ids":[10],"grouping":"projects","sub_grouping":"time_entries"},
+ "created_at":"2016-06-01T09:00:00Z"}`
+
+	FixtureSavedReports = "[" + FixtureSavedReport + "]"
+
+	FixtureOrganizations = `[
+ {"id":500,"name":"Test Organization","pricing_plan_id":0,"admin":true,"owner":true,"max_workspaces":20,"user_count":2,"at":"2016-06-01T09:00:00Z"}
+]`
+
+	FixtureOrganization = `{"id":500,"name":"Test Organization","pricing_plan_id":0,"admin":true,"owner":true,"max_workspaces":20,"user_count":2,"at":"2016-06-01T09:00:00Z"}`
+
+	FixtureOrganizationUsers = `[
+ {"id":1,"user_id":1000,"name":"Test User","email":"user@example.com","admin":true,"owner":true,"inactive":false,"joined":true,
+  "workspaces":[{"workspace_id":1,"admin":true}]},
+ {"id":2,"user_id":1001,"name":"Other User","email":"other@example.com","admin":false,"owner":false,"inactive":false,"joined":true,
+  "workspaces":[{"workspace_id":1,"admin":false}]}
+]`
+
+	FixtureOrganizationGroups = `[
+ {"group_id":30,"name":"Developers","workspaces":[1],"users":[{"user_id":1000,"name":"Test User"}],"at":"2016-06-01T09:00:00Z"}
+]`
+
+	FixtureWorkspaceV9 = `{"id":1,"organization_id":500,"name":"Test Workspace","premium":false,"admin":true,"default_hourly_rate":50,
+ "default_currency":"USD","rounding":1,"rounding_minutes":0,"at":"2016-06-01T09:00:00Z"}`
+
+	FixtureFavorites = `[
+ {"favorite_id":41,"workspace_id":1,"user_id":1000,"project_id":101,"description":"Inbox","billable":false,"tags":[],"rank":2,"created_at":"2016-06-01T09:00:00Z"},
+ {"favorite_id":40,"workspace_id":1,"user_id":1000,"project_id":100,"description":"Landing page","billable":true,"tags":["dev"],"tag_ids":[20],"rank":1,"created_at":"2016-06-01T09:00:00Z"}
+]`
+
+	FixtureBadGatewayHTML = `<html>
+<head><title>502 Bad Gateway</title></head>
+<body>
+<center><h1>502 Bad Gateway</h1></center>
+<hr><center>nginx</center>
+</body>
+</html>
+`
+)
@@ -0,0 +1,116 @@
+package togglmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+// Seed is the JSON document of routes and faults loaded into a Server,
+// so suites written in other languages can set up cmd/toggl-mock
+type Seed struct {
+	Routes []SeedRoute `json:"routes"`
+	Faults []SeedFault `json:"faults"`
+}
+
+// SeedResponse is a Response in a seed document.
+// Body is any JSON value served as is, or a JSON string served as its text.
+type SeedResponse struct {
+	Status   int               `json:"status"`
+	Headers  map[string]string `json:"headers,omitempty"`
+	Body     json.RawMessage   `json:"body,omitempty"`
+	Truncate bool              `json:"truncate,omitempty"`
+}
+
+// SeedRoute is a canned response set with HandleResponse
+type SeedRoute struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	SeedResponse
+}
+
+// SeedFault are faults queued with Inject
+type SeedFault struct {
+	Method    string         `json:"method"`
+	Path      string         `json:"path"`
+	Responses []SeedResponse `json:"responses"`
+}
+
+// Response returns the response of the seed.
+func (r *SeedResponse) Response() (Response, error) {
+	response := Response{Status: r.Status, Truncate: r.Truncate}
+	if response.Status == 0 {
+		response.Status = http.StatusOK
+	}
+	if len(r.Headers) > 0 {
+		response.Header = http.Header{}
+		for k, v := range r.Headers {
+			response.Header.Set(k, v)
+		}
+	}
+	if len(r.Body) > 0 {
+		if r.Body[0] == '"' {
+			if err := json.Unmarshal(r.Body, &response.Body); err != nil {
+				return Response{}, err
+			}
+		} else {
+			response.Body = string(r.Body)
+		}
+	}
+	return response, nil
+}
+
+// Load sets the routes and queues the faults of the seed.
+func (s *Server) Load(seed *Seed) error {
+	for i := range seed.Routes {
+		rt := &seed.Routes[i]
+		if rt.Method == "" || rt.Path == "" {
+			return fmt.Errorf("route %d: method and path are required", i)
+		}
+		response, err := rt.Response()
+		if err != nil {
+			return fmt.Errorf("route %s %s: %v", rt.Method, rt.Path, err)
+		}
+		s.HandleResponse(rt.Method, rt.Path, response)
+	}
+	for i := range seed.Faults {
+		f := &seed.Faults[i]
+		if f.Method == "" || f.Path == "" {
+			return fmt.Errorf("fault %d: method and path are required", i)
+		}
+		faults := make([]Response, 0, len(f.Responses))
+		for j := range f.Responses {
+			response, err := f.Responses[j].Response()
+			if err != nil {
+				return fmt.Errorf("fault %s %s: %v", f.Method, f.Path, err)
+			}
+			faults = append(faults, response)
+		}
+		s.Inject(f.Method, f.Path, faults...)
+	}
+	return nil
+}
+
+// LoadSeed loads the seed document read from r.
+func (s *Server) LoadSeed(r io.Reader) error {
+	seed := &Seed{}
+	if err := json.NewDecoder(r).Decode(seed); err != nil {
+		return err
+	}
+	return s.Load(seed)
+}
+
+// LoadSeedFile loads the seed document of the file.
+func (s *Server) LoadSeedFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := s.LoadSeed(f); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
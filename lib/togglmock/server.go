@@ -0,0 +1,407 @@
+// Package togglmock provides a fake toggl API server for testing code built on the client.
+package togglmock
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+)
+
+// Request is a request received by the Server
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Response is a canned response of the Server
+type Response struct {
+	Status int
+	Header http.Header
+	Body   string
+	// Truncate cuts the connection after half of Body, which is announced with its full
+	// Content-Length, so the client reads an unexpected EOF. An injected truncated fault
+	// without Body cuts the canned response of the route.
+	Truncate bool
+}
+
+type route struct {
+	method   string
+	segments []string
+	response Response
+}
+
+// faultQueue are the faults injected for a method and pattern
+type faultQueue struct {
+	method   string
+	pattern  string
+	segments []string
+	faults   []Response
+}
+
+// Server is a fake toggl API on top of httptest.Server.
+// It serves canned JSON for every endpoint supported by the client, records received requests
+// and returns injected faults before the canned responses.
+type Server struct {
+	// Token is the API token the server accepts. Any token is accepted when it is empty.
+	Token string
+
+	server *httptest.Server
+
+	mu       sync.Mutex
+	routes   []*route
+	faults   []*faultQueue
+	requests []Request
+}
+
+// NewServer starts a Server serving the default fixtures.
+func NewServer() *Server {
+	s := New()
+	s.server = httptest.NewServer(s)
+	return s
+}
+
+// New returns a Server serving the default fixtures without starting it,
+// to be served as an http.Handler, e.g. by cmd/toggl-mock. URL, Close and NewClient need NewServer.
+func New() *Server {
+	s := &Server{}
+	s.Handle("GET", "/api/v8/me", http.StatusOK, FixtureMe)
+	s.Handle("GET", "/api/v8/workspaces", http.StatusOK, FixtureWorkspaces)
+	s.Handle("GET", "/api/v8/workspaces/*", http.StatusOK, FixtureWorkspace)
+	s.Handle("GET", "/api/v8/workspaces/*/projects", http.StatusOK, FixtureProjects)
+	s.Handle("GET", "/api/v8/workspaces/*/clients", http.StatusOK, FixtureClients)
+	s.Handle("GET", "/api/v8/workspaces/*/tags", http.StatusOK, FixtureTags)
+	s.Handle("GET", "/api/v8/clients", http.StatusOK, FixtureClients)
+	s.Handle("POST", "/api/v8/clients", http.StatusOK, FixtureClient)
+	s.Handle("PUT", "/api/v8/clients/*", http.StatusOK, FixtureClient)
+	s.Handle("DELETE", "/api/v8/clients/*", http.StatusOK, "")
+	s.Handle("POST", "/api/v8/projects", http.StatusOK, FixtureProject)
+	s.Handle("PUT", "/api/v8/projects/*", http.StatusOK, FixtureProject)
+	s.Handle("DELETE", "/api/v8/projects/*", http.StatusOK, "")
+	s.Handle("POST", "/api/v8/tasks", http.StatusOK, FixtureTask)
+	s.Handle("POST", "/api/v8/project_users", http.StatusOK, FixtureProjectUser)
+	s.Handle("POST", "/api/v8/tags", http.StatusOK, FixtureTag)
+	s.Handle("PUT", "/api/v8/tags/*", http.StatusOK, FixtureTag)
+	s.Handle("DELETE", "/api/v8/tags/*", http.StatusOK, "")
+	s.Handle("GET", "/api/v8/workspaces/*/groups", http.StatusOK, FixtureGroups)
+	s.Handle("GET", "/api/v8/workspaces/*/workspace_users", http.StatusOK, FixtureWorkspaceUsers)
+	s.Handle("POST", "/api/v8/groups", http.StatusOK, FixtureGroup)
+	s.Handle("PUT", "/api/v8/groups/*", http.StatusOK, FixtureGroup)
+	s.Handle("DELETE", "/api/v8/groups/*", http.StatusOK, "")
+	s.Handle("PUT", "/api/v8/workspace_users/*", http.StatusOK, FixtureWorkspaceUser)
+	s.Handle("POST", "/api/v8/time_entries/start", http.StatusOK, FixtureTimeEntry)
+	s.Handle("GET", "/api/v8/time_entries", http.StatusOK, FixtureTimeEntries)
+	s.Handle("POST", "/api/v8/time_entries", http.StatusOK, FixtureStoppedTimeEntry)
+	s.Handle("GET", "/api/v8/time_entries/*", http.StatusOK, FixtureStoppedTimeEntry)
+	s.Handle("PUT", "/api/v8/time_entries/*", http.StatusOK, FixtureStoppedTimeEntry)
+	s.Handle("DELETE", "/api/v8/time_entries/*", http.StatusOK, "")
+	s.Handle("GET", "/api/v8/time_entries/current", http.StatusOK, FixtureTimeEntry)
+	s.Handle("PUT", "/api/v8/time_entries/*/stop", http.StatusOK, FixtureStoppedTimeEntry)
+	s.Handle("GET", "/webhooks/api/v1/subscriptions/*", http.StatusOK, FixtureWebhookSubscriptions)
+	s.Handle("POST", "/webhooks/api/v1/subscriptions/*", http.StatusOK, FixtureWebhookSubscription)
+	s.Handle("PUT", "/webhooks/api/v1/subscriptions/*/*", http.StatusOK, FixtureWebhookSubscription)
+	s.Handle("DELETE", "/webhooks/api/v1/subscriptions/*/*", http.StatusOK, "")
+	s.Handle("GET", "/reports/api/v2/weekly", http.StatusOK, FixtureReportWeekly)
+	s.Handle("GET", "/reports/api/v2/details", http.StatusOK, FixtureReportDetailed)
+	s.Handle("GET", "/reports/api/v2/summary", http.StatusOK, FixtureReportSummary)
+	s.HandleResponse("GET", "/reports/api/v2/details.csv", Response{
+		Status: http.StatusOK,
+		Header: http.Header{"Content-Type": {"text/csv"}},
+		Body:   FixtureReportDetailedCSV,
+	})
+	s.Handle("POST", "/reports/api/v3/workspace/*/search/time_entries", http.StatusOK, FixtureReportsV3Search)
+	s.Handle("POST", "/reports/api/v3/workspace/*/summary/time_entries", http.StatusOK, FixtureReportsV3Summary)
+	s.Handle("GET", "/reports/api/v3/workspace/*/shared", http.StatusOK, FixtureSavedReports)
+	s.Handle("GET", "/reports/api/v3/shared/*", http.StatusOK, FixtureSavedReport)
+	s.Handle("POST", "/reports/api/v3/shared", http.StatusOK, FixtureSavedReport)
+	s.Handle("DELETE", "/reports/api/v3/shared", http.StatusOK, "")
+	s.Handle("GET", "/api/v9/me/organizations", http.StatusOK, FixtureOrganizations)
+	s.Handle("GET", "/api/v9/organizations/*", http.StatusOK, FixtureOrganization)
+	s.Handle("GET", "/api/v9/organizations/*/users", http.StatusOK, FixtureOrganizationUsers)
+	s.Handle("GET", "/api/v9/organizations/*/groups", http.StatusOK, FixtureOrganizationGroups)
+	s.Handle("POST", "/api/v9/organizations/*/workspaces", http.StatusOK, FixtureWorkspaceV9)
+	s.Handle("PUT", "/api/v9/workspaces/*", http.StatusOK, FixtureWorkspaceV9)
+	s.Handle("GET", "/api/v9/workspaces/*/favorites", http.StatusOK, FixtureFavorites)
+	return s
+}
+
+// URL returns the base URL of the server, to be given to client.WithBaseURL.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// NewClient returns a client sending every request to the server.
+func (s *Server) NewClient(opts ...client.Option) (*client.Client, error) {
+	token := s.Token
+	if token == "" {
+		token = "test-token"
+	}
+	opts = append([]client.Option{client.WithBaseURL(s.URL())}, opts...)
+	return client.NewClient(&client.APIKey{Token: token, Secret: "api_token"}, &client.Resources{}, opts...)
+}
+
+// Handle sets the canned response of method and path.
+// A "*" segment in pattern matches any path segment. Later patterns take precedence.
+func (s *Server) Handle(method, pattern string, status int, body string) {
+	s.HandleResponse(method, pattern, Response{Status: status, Body: body})
+}
+
+// HandleResponse sets the canned response of method and path, with headers.
+func (s *Server) HandleResponse(method, pattern string, response Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes = append([]*route{{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		response: response,
+	}}, s.routes...)
+}
+
+// Inject queues faults returned, one per request, before the canned response of method and path.
+// When patterns of several queues match a request, the queue injected first answers it.
+func (s *Server) Inject(method, pattern string, faults ...Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, q := range s.faults {
+		if q.method == method && q.pattern == pattern {
+			q.faults = append(q.faults, faults...)
+			return
+		}
+	}
+	s.faults = append(s.faults, &faultQueue{
+		method:   method,
+		pattern:  pattern,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		faults:   faults,
+	})
+}
+
+// InjectError queues an error response with toggl's error body.
+func (s *Server) InjectError(method, pattern string, status int, message string) {
+	s.Inject(method, pattern, Error(status, message))
+}
+
+// InjectRateLimit queues a 429 response asking to retry after the given duration.
+func (s *Server) InjectRateLimit(method, pattern string, retryAfter time.Duration) {
+	s.Inject(method, pattern, RateLimited(retryAfter))
+}
+
+// Error returns a response with toggl's error body.
+func Error(status int, message string) Response {
+	return Response{
+		Status: status,
+		Header: http.Header{"Content-Type": {"application/json"}},
+		Body:   fmt.Sprintf(`{"error":{"code":%d,"message":%q}}`, status, message),
+	}
+}
+
+// RateLimited returns a 429 response with a Retry-After header.
+func RateLimited(retryAfter time.Duration) Response {
+	return Response{
+		Status: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": {fmt.Sprint(int(retryAfter.Seconds()))}},
+		Body:   "Too many requests",
+	}
+}
+
+// RateLimitedUntil returns a 429 response with a Retry-After header in HTTP date format.
+func RateLimitedUntil(t time.Time) Response {
+	return Response{
+		Status: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": {t.UTC().Format(http.TimeFormat)}},
+		Body:   "Too many requests",
+	}
+}
+
+// BadGateway returns the HTML 502 page of a proxy in front of the API.
+func BadGateway() Response {
+	return Response{
+		Status: http.StatusBadGateway,
+		Header: http.Header{"Content-Type": {"text/html"}},
+		Body:   FixtureBadGatewayHTML,
+	}
+}
+
+// EmptyBody returns a 200 JSON response without body.
+func EmptyBody() Response {
+	return Response{Status: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}}
+}
+
+// Truncated returns the canned response of the route cut half way, see Response.Truncate.
+func Truncated() Response {
+	return Response{Truncate: true}
+}
+
+// InjectBadGateway queues the HTML 502 page of a proxy.
+func (s *Server) InjectBadGateway(method, pattern string) {
+	s.Inject(method, pattern, BadGateway())
+}
+
+// InjectEmptyBody queues a 200 response without body.
+func (s *Server) InjectEmptyBody(method, pattern string) {
+	s.Inject(method, pattern, EmptyBody())
+}
+
+// InjectTruncated queues the canned response of the route cut half way.
+func (s *Server) InjectTruncated(method, pattern string) {
+	s.Inject(method, pattern, Truncated())
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// LastRequest returns the latest request received.
+func (s *Server) LastRequest() (Request, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.requests) == 0 {
+		return Request{}, false
+	}
+	return s.requests[len(s.requests)-1], true
+}
+
+// RequestsTo returns the requests received for method and pattern.
+func (s *Server) RequestsTo(method, pattern string) []Request {
+	segments := strings.Split(strings.Trim(pattern, "/"), "/")
+	var matched []Request
+	for _, r := range s.Requests() {
+		if r.Method == method && match(segments, r.Path) {
+			matched = append(matched, r)
+		}
+	}
+	return matched
+}
+
+// Reset forgets received requests and queued faults.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.faults = nil
+}
+
+func match(segments []string, path string) bool {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) != len(segments) {
+		return false
+	}
+	for i, segment := range segments {
+		if segment != "*" && segment != parts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	response, ok := s.respond(r)
+	s.mu.Unlock()
+
+	if !ok {
+		response = Error(http.StatusNotFound, fmt.Sprintf("%s %s is not served by togglmock", r.Method, r.URL.Path))
+	}
+	// Responses with an ETag header answer conditional requests like the API
+	if etag := headerValue(response.Header, "ETag"); etag != "" && r.Header.Get("If-None-Match") == etag {
+		response = Response{Status: http.StatusNotModified, Header: http.Header{"ETag": {etag}}}
+	}
+	if s.Token != "" {
+		if token, _, _ := r.BasicAuth(); token != s.Token {
+			response = Response{Status: http.StatusForbidden}
+		}
+	}
+	write(w, response)
+}
+
+// headerValue returns the header of canned responses, whose keys may not be canonical.
+func headerValue(header http.Header, name string) string {
+	for k, v := range header {
+		if strings.EqualFold(k, name) && len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// respond picks the queued fault or the canned response. s.mu must be held.
+func (s *Server) respond(r *http.Request) (Response, bool) {
+	for _, q := range s.faults {
+		if q.method != r.Method || len(q.faults) == 0 || !match(q.segments, r.URL.Path) {
+			continue
+		}
+		fault := q.faults[0]
+		q.faults = q.faults[1:]
+		if fault.Truncate && fault.Body == "" {
+			if canned, ok := s.route(r); ok {
+				canned.Truncate = true
+				return canned, true
+			}
+		}
+		return fault, true
+	}
+	return s.route(r)
+}
+
+// route returns the canned response of the request. s.mu must be held.
+func (s *Server) route(r *http.Request) (Response, bool) {
+	for _, rt := range s.routes {
+		if rt.method == r.Method && match(rt.segments, r.URL.Path) {
+			return rt.response, true
+		}
+	}
+	return Response{}, false
+}
+
+func write(w http.ResponseWriter, response Response) {
+	for k, v := range response.Header {
+		w.Header()[k] = v
+	}
+	if w.Header().Get("Content-Type") == "" && response.Body != "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	status := response.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	if response.Truncate {
+		w.Header().Set("Content-Length", fmt.Sprint(len(response.Body)))
+		w.WriteHeader(status)
+		io.WriteString(w, response.Body[:len(response.Body)/2])
+		if hj, ok := w.(http.Hijacker); ok {
+			if conn, _, err := hj.Hijack(); err == nil {
+				conn.Close()
+			}
+		}
+		return
+	}
+	w.WriteHeader(status)
+	io.WriteString(w, response.Body)
+}
@@ -0,0 +1,28 @@
+package togglmock
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestInjectOrder(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.Inject("GET", "/api/v8/time_entries/*", Error(http.StatusInternalServerError, "first"), Error(http.StatusInternalServerError, "second"))
+	server.Inject("GET", "/api/v8/time_entries/5000", Error(http.StatusBadGateway, "third"))
+
+	// The queue injected first answers while it has faults, whatever the map order would be
+	want := []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK}
+	for i, status := range want {
+		resp, err := http.Get(server.URL() + "/api/v8/time_entries/5000")
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != status {
+			t.Errorf("request %d: status %d, want %d", i, resp.StatusCode, status)
+		}
+	}
+}
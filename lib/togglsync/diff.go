@@ -0,0 +1,160 @@
+package togglsync
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+)
+
+// Op is what a Change does
+type Op string
+
+// Ops of changes
+const (
+	OpCreate Op = "create"
+	OpUpdate Op = "update"
+	OpDelete Op = "delete"
+)
+
+// Change is a created, updated or deleted item. Old is nil for OpCreate, New nil for OpDelete.
+type Change[T any] struct {
+	Op  Op
+	ID  int64
+	Old *T
+	New *T
+}
+
+// Changes are the differences of two snapshots
+type Changes struct {
+	Projects    []Change[client.Project]
+	Clients     []Change[client.ClientData]
+	Tags        []Change[client.Tag]
+	TimeEntries []Change[client.TimeEntry]
+}
+
+// Empty reports whether there are no changes.
+func (c *Changes) Empty() bool {
+	return len(c.Projects) == 0 && len(c.Clients) == 0 && len(c.Tags) == 0 && len(c.TimeEntries) == 0
+}
+
+// Diff returns the changes turning base into next. Items are matched by ID, and items
+// without ID, like time entries created offline, are created. Only At differing is no change.
+// Time entries missing in next are only deleted when they started in the window of next,
+// so entries moving out of a moving window are kept. A nil base is an empty snapshot.
+func Diff(base, next *Snapshot) *Changes {
+	if base == nil {
+		base = &Snapshot{}
+	}
+	entries := diff(base.TimeEntries, next.TimeEntries, func(e *client.TimeEntry) int64 { return e.ID })
+	kept := entries[:0]
+	for _, c := range entries {
+		if c.Op != OpDelete || next.covers(c.Old.Start) {
+			kept = append(kept, c)
+		}
+	}
+	return &Changes{
+		Projects:    diff(base.Projects, next.Projects, func(p *client.Project) int64 { return int64(p.ID) }),
+		Clients:     diff(base.Clients, next.Clients, func(c *client.ClientData) int64 { return int64(c.ID) }),
+		Tags:        diff(base.Tags, next.Tags, func(t *client.Tag) int64 { return int64(t.ID) }),
+		TimeEntries: kept,
+	}
+}
+
+// covers reports whether the time entries of the snapshot include the ones started at start.
+// A snapshot without window covers everything.
+func (s *Snapshot) covers(start time.Time) bool {
+	return (s.Since.IsZero() || !start.Before(s.Since)) && (s.Until.IsZero() || !start.After(s.Until))
+}
+
+func diff[T any](base, next []T, id func(*T) int64) []Change[T] {
+	old := make(map[int64]*T, len(base))
+	for i := range base {
+		old[id(&base[i])] = &base[i]
+	}
+	var changes []Change[T]
+	seen := make(map[int64]bool, len(next))
+	for i := range next {
+		n := &next[i]
+		key := id(n)
+		if key <= 0 {
+			changes = append(changes, Change[T]{Op: OpCreate, New: n})
+			continue
+		}
+		seen[key] = true
+		o, ok := old[key]
+		switch {
+		case !ok:
+			changes = append(changes, Change[T]{Op: OpCreate, ID: key, New: n})
+		case !same(o, n):
+			changes = append(changes, Change[T]{Op: OpUpdate, ID: key, Old: o, New: n})
+		}
+	}
+	for i := range base {
+		if key := id(&base[i]); key > 0 && !seen[key] {
+			changes = append(changes, Change[T]{Op: OpDelete, ID: key, Old: &base[i]})
+		}
+	}
+	return changes
+}
+
+// same compares the JSON of the items without their "at" timestamp, so times in other
+// locations and the modification time of the server do not count as changes.
+func same(a, b interface{}) bool {
+	return bytes.Equal(canonical(a), canonical(b))
+}
+
+func canonical(v interface{}) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return b
+	}
+	delete(fields, "at")
+	b, _ = json.Marshal(fields)
+	return b
+}
+
+// Apply applies the changes to the snapshot in place.
+func (s *Snapshot) Apply(c *Changes) {
+	s.Projects = apply(s.Projects, c.Projects, func(p *client.Project) int64 { return int64(p.ID) })
+	s.Clients = apply(s.Clients, c.Clients, func(c *client.ClientData) int64 { return int64(c.ID) })
+	s.Tags = apply(s.Tags, c.Tags, func(t *client.Tag) int64 { return int64(t.ID) })
+	s.TimeEntries = apply(s.TimeEntries, c.TimeEntries, func(e *client.TimeEntry) int64 { return e.ID })
+}
+
+func apply[T any](items []T, changes []Change[T], id func(*T) int64) []T {
+	index := make(map[int64]int, len(items))
+	for i := range items {
+		index[id(&items[i])] = i
+	}
+	deleted := map[int64]bool{}
+	for _, c := range changes {
+		switch c.Op {
+		case OpCreate:
+			items = append(items, *c.New)
+		case OpUpdate:
+			if i, ok := index[c.ID]; ok {
+				items[i] = *c.New
+			} else {
+				items = append(items, *c.New)
+			}
+		case OpDelete:
+			deleted[c.ID] = true
+		}
+	}
+	if len(deleted) == 0 {
+		return items
+	}
+	kept := items[:0]
+	for i := range items {
+		if !deleted[id(&items[i])] {
+			kept = append(kept, items[i])
+		}
+	}
+	return kept
+}
@@ -0,0 +1,113 @@
+// Package togglsync keeps a local snapshot of a workspace in a Store, computes the changes
+// between snapshots and applies them, for offline-first tools and two-way integrations
+// like syncing time entries with worklogs of an issue tracker.
+// Clients, projects, tags and time entries are synced both ways.
+package togglsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+)
+
+// ErrNoSnapshot is returned by Store.Load before the first Save
+var ErrNoSnapshot = errors.New("no snapshot is stored")
+
+// Snapshot is the state of a workspace: its projects, clients, tags and the time entries
+// started in the window from Since to Until
+type Snapshot struct {
+	WorkspaceID int                 `json:"workspace_id"`
+	Since       time.Time           `json:"since"`
+	Until       time.Time           `json:"until"`
+	TakenAt     time.Time           `json:"taken_at"`
+	Projects    []client.Project    `json:"projects"`
+	Clients     []client.ClientData `json:"clients"`
+	Tags        []client.Tag        `json:"tags"`
+	TimeEntries []client.TimeEntry  `json:"time_entries"`
+}
+
+// Store keeps the last synced snapshot, e.g. in a JSON file or a SQLite database
+type Store interface {
+	// Load returns the stored snapshot, ErrNoSnapshot when there is none.
+	Load(ctx context.Context) (*Snapshot, error)
+	Save(ctx context.Context, s *Snapshot) error
+}
+
+// FileStore stores the snapshot as a JSON file
+type FileStore struct {
+	Path string
+}
+
+// Load implements Store.
+func (f *FileStore) Load(ctx context.Context) (*Snapshot, error) {
+	b, err := os.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return nil, ErrNoSnapshot
+	}
+	if err != nil {
+		return nil, err
+	}
+	s := &Snapshot{}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Save implements Store. The file is replaced at once, so a crash leaves the previous snapshot.
+func (f *FileStore) Save(ctx context.Context, s *Snapshot) error {
+	b, err := json.MarshalIndent(s, "", " ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), filepath.Base(f.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.Path)
+}
+
+// Fetch takes a snapshot of the workspace, the default workspace of c when 0,
+// with the time entries started between since and until.
+// Cached lookups and responses of the workspace are dropped first, so the snapshot is current.
+func Fetch(ctx context.Context, c *client.Client, workspaceID int, since, until time.Time) (*Snapshot, error) {
+	if workspaceID == 0 {
+		workspaceID = c.DefaultWorkspace()
+	}
+	c.InvalidateWorkspace(workspaceID)
+	s := &Snapshot{WorkspaceID: workspaceID, Since: since, Until: until, TakenAt: time.Now()}
+	var err error
+	if s.Projects, err = c.Projects.ListAll(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+	if s.Clients, err = c.Clients.List(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+	if s.Tags, err = c.Tags.List(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+	entries, err := c.TimeEntries.List(ctx, since, until)
+	if err != nil {
+		return nil, err
+	}
+	s.TimeEntries = make([]client.TimeEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.WorkspaceID == workspaceID || e.WorkspaceID == 0 {
+			s.TimeEntries = append(s.TimeEntries, e)
+		}
+	}
+	return s, nil
+}
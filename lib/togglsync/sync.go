@@ -0,0 +1,230 @@
+package togglsync
+
+import (
+	"context"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+)
+
+// Syncer synchronizes a workspace with the snapshot of its Store.
+// The stored snapshot is the base both sides changed from since the last sync.
+type Syncer struct {
+	Client *client.Client
+	Store  Store
+	// WorkspaceID is the synchronized workspace, the default workspace of Client when 0
+	WorkspaceID int
+	// Window returns the window of time entries to synchronize, the last 9 days when nil
+	Window func(now time.Time) (since, until time.Time)
+}
+
+func (s *Syncer) fetch(ctx context.Context) (*Snapshot, error) {
+	now := time.Now()
+	since, until := now.AddDate(0, 0, -9), now
+	if s.Window != nil {
+		since, until = s.Window(now)
+	}
+	return Fetch(ctx, s.Client, s.WorkspaceID, since, until)
+}
+
+func (s *Syncer) base(ctx context.Context) (*Snapshot, error) {
+	base, err := s.Store.Load(ctx)
+	if err == ErrNoSnapshot {
+		return &Snapshot{}, nil
+	}
+	return base, err
+}
+
+// Pull fetches the workspace, stores it and returns what changed remotely since the last sync,
+// for the caller to apply to its side. The first pull creates everything.
+func (s *Syncer) Pull(ctx context.Context) (*Snapshot, *Changes, error) {
+	base, err := s.base(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	remote, err := s.fetch(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.Store.Save(ctx, remote); err != nil {
+		return nil, nil, err
+	}
+	return remote, Diff(base, remote), nil
+}
+
+// PushResult is the outcome of Push
+type PushResult struct {
+	// Applied are the local changes sent to toggl. Created items have the ID toggl gave them.
+	Applied Changes
+	// Conflicts are local changes of items also changed or deleted remotely since the last sync.
+	// They are not sent: the remote version wins, and is in the snapshot Push returns.
+	Conflicts Changes
+	// Snapshot is the state stored after the push
+	Snapshot *Snapshot
+}
+
+// Push sends the clients, projects, tags and time entries changed in local, an edited copy of
+// the stored snapshot, to toggl. Items created in local have no ID; time entries can only refer
+// to projects and tags which have one, so those created in the same push are referred to after it.
+// The stored snapshot is updated after every applied change, and the IDs of created items are
+// written back into local, so a Push failing part way can be retried with local without
+// creating anything twice. The workspace is fetched and stored again at the end.
+func (s *Syncer) Push(ctx context.Context, local *Snapshot) (*PushResult, error) {
+	base, err := s.base(ctx)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := s.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	remoteChanges, localChanges := Diff(base, remote), Diff(base, local)
+	workspaceID := s.WorkspaceID
+	if workspaceID == 0 {
+		workspaceID = s.Client.DefaultWorkspace()
+	}
+	c := s.Client
+	result := &PushResult{}
+
+	err = push(ctx, s, base, localChanges.Clients, remoteChanges.Clients, &result.Applied.Clients, &result.Conflicts.Clients, pusher[client.ClientData]{
+		items: func(s *Snapshot) *[]client.ClientData { return &s.Clients },
+		id:    func(v *client.ClientData) int64 { return int64(v.ID) },
+		create: func(ctx context.Context, v *client.ClientData) (int64, error) {
+			in := *v
+			in.WorkspaceID = workspaceID
+			created, err := c.Clients.Create(ctx, &in)
+			return createdID(created, err, func(v *client.ClientData) int64 { return int64(v.ID) })
+		},
+		setID: func(v *client.ClientData, id int64) { v.ID = int(id) },
+		update: func(ctx context.Context, v *client.ClientData) error {
+			_, err := c.Clients.Update(ctx, v)
+			return err
+		},
+		delete: func(ctx context.Context, id int64) error { return c.Clients.Delete(ctx, workspaceID, int(id)) },
+	})
+	if err == nil {
+		err = push(ctx, s, base, localChanges.Projects, remoteChanges.Projects, &result.Applied.Projects, &result.Conflicts.Projects, pusher[client.Project]{
+			items: func(s *Snapshot) *[]client.Project { return &s.Projects },
+			id:    func(v *client.Project) int64 { return int64(v.ID) },
+			create: func(ctx context.Context, v *client.Project) (int64, error) {
+				in := *v
+				in.WorkspaceID = workspaceID
+				created, err := c.Projects.Create(ctx, &in)
+				return createdID(created, err, func(v *client.Project) int64 { return int64(v.ID) })
+			},
+			setID: func(v *client.Project, id int64) { v.ID = int(id) },
+			update: func(ctx context.Context, v *client.Project) error {
+				_, err := c.Projects.Update(ctx, v)
+				return err
+			},
+			delete: func(ctx context.Context, id int64) error { return c.Projects.Delete(ctx, workspaceID, int(id)) },
+		})
+	}
+	if err == nil {
+		err = push(ctx, s, base, localChanges.Tags, remoteChanges.Tags, &result.Applied.Tags, &result.Conflicts.Tags, pusher[client.Tag]{
+			items: func(s *Snapshot) *[]client.Tag { return &s.Tags },
+			id:    func(v *client.Tag) int64 { return int64(v.ID) },
+			create: func(ctx context.Context, v *client.Tag) (int64, error) {
+				in := *v
+				in.WorkspaceID = workspaceID
+				created, err := c.Tags.Create(ctx, &in)
+				return createdID(created, err, func(v *client.Tag) int64 { return int64(v.ID) })
+			},
+			setID: func(v *client.Tag, id int64) { v.ID = int(id) },
+			update: func(ctx context.Context, v *client.Tag) error {
+				_, err := c.Tags.Update(ctx, v)
+				return err
+			},
+			delete: func(ctx context.Context, id int64) error { return c.Tags.Delete(ctx, workspaceID, int(id)) },
+		})
+	}
+	if err == nil {
+		err = push(ctx, s, base, localChanges.TimeEntries, remoteChanges.TimeEntries, &result.Applied.TimeEntries, &result.Conflicts.TimeEntries, pusher[client.TimeEntry]{
+			items: func(s *Snapshot) *[]client.TimeEntry { return &s.TimeEntries },
+			id:    func(v *client.TimeEntry) int64 { return v.ID },
+			create: func(ctx context.Context, v *client.TimeEntry) (int64, error) {
+				in := *v
+				in.ID = 0
+				if in.WorkspaceID == 0 && in.ProjectID == 0 {
+					in.WorkspaceID = workspaceID
+				}
+				created, err := c.TimeEntries.Create(ctx, &in)
+				return createdID(created, err, func(v *client.TimeEntry) int64 { return v.ID })
+			},
+			setID: func(v *client.TimeEntry, id int64) { v.ID = id },
+			update: func(ctx context.Context, v *client.TimeEntry) error {
+				_, err := c.TimeEntries.Update(ctx, v)
+				return err
+			},
+			delete: func(ctx context.Context, id int64) error { return c.TimeEntries.Delete(ctx, id) },
+		})
+	}
+	if err != nil {
+		return result, err
+	}
+
+	if result.Snapshot, err = s.fetch(ctx); err != nil {
+		return result, err
+	}
+	return result, s.Store.Save(ctx, result.Snapshot)
+}
+
+// pusher sends changes of items of type T to toggl
+type pusher[T any] struct {
+	// items returns the items of type T of a snapshot
+	items  func(*Snapshot) *[]T
+	id     func(*T) int64
+	setID  func(*T, int64)
+	create func(context.Context, *T) (int64, error)
+	update func(context.Context, *T) error
+	delete func(context.Context, int64) error
+}
+
+func createdID[T any](created *T, err error, id func(*T) int64) (int64, error) {
+	if err == nil && created == nil {
+		err = client.ErrNoData
+	}
+	if err != nil {
+		return 0, err
+	}
+	return id(created), nil
+}
+
+// push sends the local changes which do not conflict with remote ones, appending them to applied
+// or conflicts. Every applied change is applied to base, which is stored right away.
+// New of a created item points into the local snapshot, whose ID is set to the created one.
+func push[T any](ctx context.Context, s *Syncer, base *Snapshot, local, remote []Change[T], applied, conflicts *[]Change[T], p pusher[T]) error {
+	remoteChanged := map[int64]bool{}
+	for _, c := range remote {
+		remoteChanged[c.ID] = true
+	}
+	for _, c := range local {
+		if c.ID > 0 && remoteChanged[c.ID] {
+			*conflicts = append(*conflicts, c)
+			continue
+		}
+		var err error
+		switch c.Op {
+		case OpCreate:
+			var id int64
+			if id, err = p.create(ctx, c.New); err == nil {
+				p.setID(c.New, id)
+				c.ID = id
+			}
+		case OpUpdate:
+			err = p.update(ctx, c.New)
+		case OpDelete:
+			err = p.delete(ctx, c.ID)
+		}
+		if err != nil {
+			return err
+		}
+		*applied = append(*applied, c)
+		items := p.items(base)
+		*items = apply(*items, []Change[T]{c}, p.id)
+		if err := s.Store.Save(ctx, base); err != nil {
+			return err
+		}
+	}
+	return nil
+}
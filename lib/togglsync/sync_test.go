@@ -0,0 +1,244 @@
+package togglsync
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+var day = time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func entry(id int64, days int, description string) client.TimeEntry {
+	start := day.AddDate(0, 0, days)
+	return client.TimeEntry{ID: id, WorkspaceID: 1, Description: description, Start: start, Stop: start.Add(time.Hour), Duration: time.Hour}
+}
+
+func TestDiff(t *testing.T) {
+	base := &Snapshot{
+		Since:       day,
+		Until:       day.AddDate(0, 0, 9),
+		Tags:        []client.Tag{{ID: 1, Name: "dev"}, {ID: 2, Name: "ops"}},
+		TimeEntries: []client.TimeEntry{entry(1, 0, "old"), entry(2, 7, "kept"), entry(3, 8, "gone")},
+	}
+	// The window moved on by four days: entry 1 is out of it, not deleted
+	next := &Snapshot{
+		Since:       day.AddDate(0, 0, 4),
+		Until:       day.AddDate(0, 0, 13),
+		Tags:        []client.Tag{{ID: 1, Name: "dev", At: day}, {ID: 2, Name: "infra"}},
+		TimeEntries: []client.TimeEntry{entry(2, 7, "kept"), entry(4, 12, "new"), entry(0, 12, "offline")},
+	}
+
+	changes := Diff(base, next)
+	if len(changes.Tags) != 1 || changes.Tags[0].Op != OpUpdate || changes.Tags[0].ID != 2 {
+		t.Errorf("tag changes = %+v, want an update of 2", changes.Tags)
+	}
+	want := []struct {
+		op Op
+		id int64
+	}{{OpCreate, 4}, {OpCreate, 0}, {OpDelete, 3}}
+	if len(changes.TimeEntries) != len(want) {
+		t.Fatalf("time entry changes = %+v, want %v", changes.TimeEntries, want)
+	}
+	for i, w := range want {
+		if c := changes.TimeEntries[i]; c.Op != w.op || c.ID != w.id {
+			t.Errorf("change %d = %s %d, want %s %d", i, c.Op, c.ID, w.op, w.id)
+		}
+	}
+
+	if first := Diff(nil, next); len(first.TimeEntries) != 3 || len(first.Tags) != 2 {
+		t.Errorf("Diff(nil) = %+v, want everything created", first)
+	}
+	if again := Diff(next, next); len(again.TimeEntries) != 1 || again.TimeEntries[0].Op != OpCreate {
+		t.Errorf("Diff(next, next) = %+v, want only the offline entry created", again)
+	}
+}
+
+func TestApply(t *testing.T) {
+	base := &Snapshot{TimeEntries: []client.TimeEntry{entry(1, 0, "one"), entry(2, 1, "two"), entry(3, 2, "three")}}
+	next := &Snapshot{TimeEntries: []client.TimeEntry{entry(1, 0, "one"), entry(2, 1, "second"), entry(4, 3, "four")}}
+
+	base.Apply(Diff(base, next))
+	if changes := Diff(base, next); !changes.Empty() {
+		t.Errorf("changes after Apply = %+v, want none", changes)
+	}
+	if len(base.TimeEntries) != 3 {
+		t.Errorf("entries = %+v, want 3", base.TimeEntries)
+	}
+}
+
+type memoryStore struct {
+	snapshot *Snapshot
+}
+
+func (m *memoryStore) Load(ctx context.Context) (*Snapshot, error) {
+	if m.snapshot == nil {
+		return nil, ErrNoSnapshot
+	}
+	s := *m.snapshot
+	s.Projects = append([]client.Project(nil), m.snapshot.Projects...)
+	s.Clients = append([]client.ClientData(nil), m.snapshot.Clients...)
+	s.Tags = append([]client.Tag(nil), m.snapshot.Tags...)
+	s.TimeEntries = append([]client.TimeEntry(nil), m.snapshot.TimeEntries...)
+	return &s, nil
+}
+
+func (m *memoryStore) Save(ctx context.Context, s *Snapshot) error {
+	m.snapshot = s
+	return nil
+}
+
+func TestPush(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &memoryStore{}
+	syncer := &Syncer{
+		Client:      c,
+		Store:       store,
+		WorkspaceID: 1,
+		Window: func(now time.Time) (time.Time, time.Time) {
+			return day, day.AddDate(0, 0, 9)
+		},
+	}
+	ctx := context.Background()
+
+	if _, _, err := syncer.Pull(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// Pretend "Landing page" of togglmock.FixtureTimeEntries changed remotely since the last sync
+	for i := range store.snapshot.TimeEntries {
+		if store.snapshot.TimeEntries[i].ID == 4999 {
+			store.snapshot.TimeEntries[i].Description = "Landing"
+		}
+	}
+	local, err := store.Load(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range local.TimeEntries {
+		local.TimeEntries[i].Description += " (edited)"
+	}
+	local.TimeEntries = append(local.TimeEntries, entry(0, 8, "offline"))
+	server.Reset()
+
+	result, err := syncer.Push(ctx, local)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conflicts := result.Conflicts.TimeEntries; len(conflicts) != 1 || conflicts[0].ID != 4999 {
+		t.Errorf("conflicts = %+v, want 4999", conflicts)
+	}
+	if applied := result.Applied.TimeEntries; len(applied) != 2 || applied[1].ID != 5000 {
+		t.Errorf("applied = %+v, want the update of 4998 and the offline entry created as 5000", applied)
+	}
+	if id := local.TimeEntries[len(local.TimeEntries)-1].ID; id != 5000 {
+		t.Errorf("ID of the offline entry in local = %d, want 5000", id)
+	}
+	if got := len(server.RequestsTo("PUT", "/api/v8/time_entries/4998")); got != 1 {
+		t.Errorf("updates of 4998 = %d, want 1", got)
+	}
+	if got := len(server.RequestsTo("PUT", "/api/v8/time_entries/4999")); got != 0 {
+		t.Errorf("updates of conflicting 4999 = %d, want 0", got)
+	}
+	if got := len(server.RequestsTo("POST", "/api/v8/time_entries")); got != 1 {
+		t.Errorf("creates = %d, want 1", got)
+	}
+	if store.snapshot != result.Snapshot {
+		t.Error("the snapshot after the push is not stored")
+	}
+}
+
+func TestPushWorkspaceItems(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &memoryStore{}
+	syncer := &Syncer{Client: c, Store: store, WorkspaceID: 1}
+	ctx := context.Background()
+	if _, _, err := syncer.Pull(ctx); err != nil {
+		t.Fatal(err)
+	}
+	local, err := store.Load(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Rename client Acme (10), create project Research, delete tag meeting (21)
+	local.Clients[0].Name = "Acme Corp"
+	local.Projects = append(local.Projects, client.Project{Name: "Research", Active: true})
+	local.Tags = local.Tags[:1]
+	result, err := syncer.Push(ctx, local)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(server.RequestsTo("PUT", "/api/v8/clients/10")); got != 1 {
+		t.Errorf("updates of client 10 = %d, want 1", got)
+	}
+	if got := len(server.RequestsTo("POST", "/api/v8/projects")); got != 1 {
+		t.Errorf("projects created = %d, want 1", got)
+	}
+	if got := len(server.RequestsTo("DELETE", "/api/v8/tags/21")); got != 1 {
+		t.Errorf("deletes of tag 21 = %d, want 1", got)
+	}
+	if applied := result.Applied; len(applied.Clients) != 1 || len(applied.Projects) != 1 || applied.Projects[0].ID != 102 || len(applied.Tags) != 1 {
+		t.Errorf("applied = %+v, want a change of each", applied)
+	}
+}
+
+func TestPushFailure(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &memoryStore{}
+	window := func(now time.Time) (time.Time, time.Time) { return day, day.AddDate(0, 0, 9) }
+	syncer := &Syncer{Client: c, Store: store, WorkspaceID: 1, Window: window}
+	ctx := context.Background()
+	if _, _, err := syncer.Pull(ctx); err != nil {
+		t.Fatal(err)
+	}
+	local, err := store.Load(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	local.TimeEntries = append(local.TimeEntries, entry(0, 7, "first"), entry(0, 8, "second"))
+
+	// The second create fails after the first one went through
+	server.Reset()
+	server.Inject("POST", "/api/v8/time_entries", togglmock.Response{Status: http.StatusOK, Body: togglmock.FixtureStoppedTimeEntry}, togglmock.Error(http.StatusBadRequest, "bad entry"))
+	result, err := syncer.Push(ctx, local)
+	if err == nil {
+		t.Fatal("Push succeeded, want the error of the second create")
+	}
+	if applied := result.Applied.TimeEntries; len(applied) != 1 || applied[0].ID != 5000 {
+		t.Errorf("applied = %+v, want the first entry created as 5000", applied)
+	}
+	if n := len(store.snapshot.TimeEntries); n != len(local.TimeEntries)-1 {
+		t.Errorf("stored %d entries, want the first entry stored with the fetched ones", n)
+	}
+
+	// Retrying creates only the entry which failed
+	server.Reset()
+	result, err = syncer.Push(ctx, local)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(server.RequestsTo("POST", "/api/v8/time_entries")); got != 1 {
+		t.Errorf("creates on retry = %d, want 1", got)
+	}
+	if applied := result.Applied.TimeEntries; len(applied) != 1 || applied[0].New.Description != "second" {
+		t.Errorf("applied on retry = %+v, want the second entry", applied)
+	}
+}
@@ -0,0 +1,168 @@
+// Package validate checks time entries before they are created and rounds their durations
+// like the rounding settings of a workspace, so invoices made from the library match toggl.
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+)
+
+// Errors of Entry
+var (
+	ErrStopBeforeStart  = errors.New("time entry stops before it starts")
+	ErrNoStart          = errors.New("time entry has no start")
+	ErrNegativeDuration = errors.New("time entry has a negative duration")
+)
+
+// Entry checks the start, stop and duration of the entry.
+// A running entry has no Stop.
+func Entry(e *client.TimeEntry) error {
+	switch {
+	case e.Start.IsZero():
+		return ErrNoStart
+	case !e.Stop.IsZero() && e.Stop.Before(e.Start):
+		return ErrStopBeforeStart
+	case e.Duration < 0:
+		return ErrNegativeDuration
+	}
+	return nil
+}
+
+// end returns the stop of the entry, Start+Duration when it has no Stop, now for a running entry.
+func end(e *client.TimeEntry, now time.Time) time.Time {
+	switch {
+	case e.IsRunning():
+		return now
+	case e.Stop.IsZero():
+		return e.Start.Add(e.Duration)
+	}
+	return e.Stop
+}
+
+// Overlapping returns the entries whose time overlaps with e, except e itself by ID.
+// Running entries last until now.
+func Overlapping(entries []client.TimeEntry, e *client.TimeEntry, now time.Time) []client.TimeEntry {
+	start, stop := e.Start, end(e, now)
+	var overlapping []client.TimeEntry
+	for i := range entries {
+		other := &entries[i]
+		if e.ID != 0 && other.ID == e.ID {
+			continue
+		}
+		if other.Start.Before(stop) && start.Before(end(other, now)) {
+			overlapping = append(overlapping, *other)
+		}
+	}
+	return overlapping
+}
+
+// neighborhood is how far before and after an entry neighbors are fetched,
+// to find running or long entries started earlier
+const neighborhood = 24 * time.Hour
+
+// Overlaps fetches the entries around e and returns the ones overlapping with it.
+// Overlaps are warnings rather than errors: toggl accepts them.
+func Overlaps(ctx context.Context, c *client.Client, e *client.TimeEntry) ([]client.TimeEntry, error) {
+	now := time.Now()
+	entries, err := c.TimeEntries.List(ctx, e.Start.Add(-neighborhood), end(e, now).Add(neighborhood))
+	if err != nil {
+		return nil, err
+	}
+	return Overlapping(entries, e, now), nil
+}
+
+// Mode is the direction durations are rounded in, with the values of Workspace.Rounding
+type Mode int
+
+// Modes of rounding
+const (
+	Down    Mode = -1
+	Nearest Mode = 0
+	Up      Mode = 1
+)
+
+func (m Mode) String() string {
+	switch m {
+	case Down:
+		return "down"
+	case Nearest:
+		return "nearest"
+	case Up:
+		return "up"
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
+// Rounding rounds durations to multiples of Increment, e.g. 6, 15 or 30 minutes.
+// The zero Rounding leaves durations unchanged.
+type Rounding struct {
+	Increment time.Duration
+	Mode      Mode
+}
+
+// WorkspaceRounding returns the rounding settings of the workspace.
+func WorkspaceRounding(w *client.Workspace) Rounding {
+	return Rounding{Increment: time.Duration(w.RoundingMinutes) * time.Minute, Mode: Mode(w.Rounding)}
+}
+
+// Round rounds the duration. Halves are rounded up in Nearest mode.
+func (r Rounding) Round(d time.Duration) time.Duration {
+	if r.Increment <= 0 || d <= 0 {
+		return d
+	}
+	switch r.Mode {
+	case Down:
+		return d.Truncate(r.Increment)
+	case Up:
+		if rounded := d.Truncate(r.Increment); rounded != d {
+			return rounded + r.Increment
+		}
+		return d
+	}
+	return d.Round(r.Increment)
+}
+
+// Entry returns a copy of the stopped entry with its duration rounded and its stop moved to match.
+// Running entries are returned unchanged.
+func (r Rounding) Entry(e *client.TimeEntry) *client.TimeEntry {
+	rounded := *e
+	if e.IsRunning() {
+		return &rounded
+	}
+	duration := e.Duration
+	if !e.Stop.IsZero() {
+		duration = e.Stop.Sub(e.Start)
+	}
+	rounded.Duration = r.Round(duration)
+	if !e.Stop.IsZero() {
+		rounded.Stop = e.Start.Add(rounded.Duration)
+	}
+	return &rounded
+}
+
+// ReportDuration returns the rounded duration of a time entry of the detailed report.
+func (r Rounding) ReportDuration(e *client.ReportTimeEntry) time.Duration {
+	return r.Round(time.Duration(e.Dur) * time.Millisecond)
+}
+
+// Create validates the entry, rounds it and creates it. Entries it overlaps with are returned
+// along with the created entry, for the caller to warn about.
+func Create(ctx context.Context, c *client.Client, e *client.TimeEntry, r Rounding) (*client.TimeEntry, []client.TimeEntry, error) {
+	if err := Entry(e); err != nil {
+		return nil, nil, err
+	}
+	rounded := r.Entry(e)
+	overlaps, err := Overlaps(ctx, c, rounded)
+	if err != nil {
+		return nil, nil, err
+	}
+	created, err := c.TimeEntries.Create(ctx, rounded)
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, overlaps, nil
+}
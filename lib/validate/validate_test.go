@@ -0,0 +1,157 @@
+package validate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func TestRound(t *testing.T) {
+	m := time.Minute
+	tests := []struct {
+		increment time.Duration
+		mode      Mode
+		in, want  time.Duration
+	}{
+		{6 * m, Down, 11 * m, 6 * m},
+		{6 * m, Up, 7 * m, 12 * m},
+		{6 * m, Nearest, 8 * m, 6 * m},
+		{6 * m, Nearest, 9 * m, 12 * m}, // half rounds up
+		{6 * m, Up, 12 * m, 12 * m},
+		{15 * m, Down, 29 * m, 15 * m},
+		{15 * m, Up, 16 * m, 30 * m},
+		{15 * m, Nearest, 7*m + 29*time.Second, 0},
+		{15 * m, Nearest, 7*m + 30*time.Second, 15 * m},
+		{30 * m, Down, 59 * m, 30 * m},
+		{30 * m, Up, time.Second, 30 * m},
+		{30 * m, Nearest, 45 * m, 60 * m},
+		{30 * m, Nearest, 44 * m, 30 * m},
+		{0, Up, 7 * m, 7 * m},
+		{15 * m, Up, 0, 0},
+	}
+	for _, tt := range tests {
+		r := Rounding{Increment: tt.increment, Mode: tt.mode}
+		if got := r.Round(tt.in); got != tt.want {
+			t.Errorf("Rounding{%v, %v}.Round(%v) = %v, want %v", tt.increment, tt.mode, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRoundingEntry(t *testing.T) {
+	start := time.Date(2016, 6, 8, 3, 0, 0, 0, time.UTC)
+	r := Rounding{Increment: 15 * time.Minute, Mode: Up}
+	tests := []struct {
+		name     string
+		entry    client.TimeEntry
+		duration time.Duration
+		stop     time.Time
+	}{
+		{"stop", client.TimeEntry{Start: start, Stop: start.Add(20 * time.Minute)}, 30 * time.Minute, start.Add(30 * time.Minute)},
+		{"duration", client.TimeEntry{Start: start, Duration: 20 * time.Minute}, 30 * time.Minute, time.Time{}},
+		{"running", client.TimeEntry{Start: start}, 0, time.Time{}},
+	}
+	for _, tt := range tests {
+		got := r.Entry(&tt.entry)
+		if got.Duration != tt.duration || !got.Stop.Equal(tt.stop) {
+			t.Errorf("%s: got duration %v stop %v, want %v %v", tt.name, got.Duration, got.Stop, tt.duration, tt.stop)
+		}
+	}
+}
+
+func TestWorkspaceRounding(t *testing.T) {
+	r := WorkspaceRounding(&client.Workspace{Rounding: -1, RoundingMinutes: 6})
+	if r != (Rounding{Increment: 6 * time.Minute, Mode: Down}) {
+		t.Errorf("WorkspaceRounding = %+v", r)
+	}
+}
+
+func TestEntry(t *testing.T) {
+	start := time.Date(2016, 6, 8, 3, 0, 0, 0, time.UTC)
+	tests := []struct {
+		entry client.TimeEntry
+		want  error
+	}{
+		{client.TimeEntry{Start: start, Stop: start.Add(time.Minute)}, nil},
+		{client.TimeEntry{Start: start}, nil},
+		{client.TimeEntry{Stop: start}, ErrNoStart},
+		{client.TimeEntry{Start: start, Stop: start.Add(-time.Second)}, ErrStopBeforeStart},
+		{client.TimeEntry{Start: start, Stop: start, Duration: -time.Second}, ErrNegativeDuration},
+	}
+	for i, tt := range tests {
+		if err := Entry(&tt.entry); err != tt.want {
+			t.Errorf("%d: Entry() = %v, want %v", i, err, tt.want)
+		}
+	}
+}
+
+func TestOverlapping(t *testing.T) {
+	at := func(h, m int) time.Time { return time.Date(2016, 6, 8, h, m, 0, 0, time.UTC) }
+	now := at(12, 0)
+	entries := []client.TimeEntry{
+		{ID: 1, Start: at(9, 0), Stop: at(10, 0)},
+		{ID: 2, Start: at(10, 0), Duration: time.Hour}, // ends at 11:00
+		{ID: 3, Start: at(11, 30)},                     // runs until now
+	}
+	tests := []struct {
+		name  string
+		entry client.TimeEntry
+		want  []int64
+	}{
+		{"inside", client.TimeEntry{Start: at(9, 15), Stop: at(9, 45)}, []int64{1}},
+		{"touching", client.TimeEntry{Start: at(11, 0), Stop: at(11, 30)}, nil},
+		{"by duration", client.TimeEntry{Start: at(10, 30), Duration: 10 * time.Minute}, []int64{2}},
+		{"running neighbor", client.TimeEntry{Start: at(11, 45), Stop: at(11, 50)}, []int64{3}},
+		{"spanning", client.TimeEntry{Start: at(8, 0), Stop: at(13, 0)}, []int64{1, 2, 3}},
+		{"itself", client.TimeEntry{ID: 1, Start: at(9, 0), Stop: at(10, 0)}, nil},
+	}
+	for _, tt := range tests {
+		var got []int64
+		for _, e := range Overlapping(entries, &tt.entry, now) {
+			got = append(got, e.ID)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	server := togglmock.NewServer()
+	defer server.Close()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	start := time.Date(2016, 6, 8, 3, 0, 0, 0, time.UTC)
+
+	// Overlaps "Landing page" of togglmock.FixtureTimeEntries, 02:00 to 04:00
+	e := &client.TimeEntry{WorkspaceID: 1, Description: "Review", Start: start, Duration: 40 * time.Minute}
+	_, overlaps, err := Create(ctx, c, e, Rounding{Increment: 30 * time.Minute, Mode: Up})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(overlaps) != 1 || overlaps[0].ID != 4999 {
+		t.Errorf("overlaps = %+v", overlaps)
+	}
+	if len(server.RequestsTo("POST", "/api/v8/time_entries")) != 1 {
+		t.Fatal("entry is not created")
+	}
+
+	_, _, err = Create(ctx, c, &client.TimeEntry{Start: start, Stop: start.Add(-time.Minute)}, Rounding{})
+	if err != ErrStopBeforeStart {
+		t.Errorf("Create() = %v, want ErrStopBeforeStart", err)
+	}
+	if len(server.RequestsTo("POST", "/api/v8/time_entries")) != 1 {
+		t.Error("invalid entry is created")
+	}
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// bodyBuffers are the buffers response bodies of the polling path are read into.
+var bodyBuffers = sync.Pool{New: func() interface{} { return new(bytes.Buffer) }}
+
+// currentDecoder decodes the current entry response into a TimeEntry owned by the caller.
+type currentDecoder struct {
+	codec   Codec
+	entry   *TimeEntry
+	running bool
+}
+
+func (d *currentDecoder) decodeStream(r io.Reader) error {
+	buf := bodyBuffers.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer bodyBuffers.Put(buf)
+	if _, err := buf.ReadFrom(r); err != nil {
+		return err
+	}
+
+	tags := d.entry.Tags[:0]
+	*d.entry = TimeEntry{Tags: tags}
+	body := struct {
+		Data *TimeEntry `json:"data"`
+	}{d.entry}
+	if err := d.codec.Unmarshal(buf.Bytes(), &body); err != nil {
+		return err
+	}
+	d.running = body.Data != nil
+	return nil
+}
+
+// CurrentInto decodes the running entry into entry, reusing its memory, and reports whether a timer runs.
+// Unlike Current, it reuses entry and a pooled response buffer, for callers polling every few seconds.
+// That saves the allocations of decoding; the ones of the HTTP round trip remain, see BenchmarkCurrentInto.
+func (s *TimeEntriesService) CurrentInto(ctx context.Context, entry *TimeEntry) (bool, error) {
+	req, err := s.client.newRequest(ctx, "GET", endpointTimeEntries+"/current", nil)
+	if err != nil {
+		return false, err
+	}
+	d := &currentDecoder{codec: s.client.codec, entry: entry}
+	if err := s.client.request(req, d); err != nil {
+		return false, err
+	}
+	return d.running, nil
+}
+
+// WatchCurrent polls the running entry every interval until ctx is done and returns the error of ctx.
+// fn is called with the first result and on every change: a timer started or stopped, or the running
+// entry edited. It gets nil when no timer runs, and the entry it gets is reused once it returns.
+// Failed polls are reported to onError, which may be nil.
+func (s *TimeEntriesService) WatchCurrent(ctx context.Context, interval time.Duration, fn func(*TimeEntry), onError func(error)) error {
+	var entries [2]TimeEntry
+	var running [2]bool
+	cur, first := 0, true
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		ok, err := s.CurrentInto(ctx, &entries[cur])
+		switch {
+		case err != nil:
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if onError != nil {
+				onError(err)
+			}
+		case first || ok != running[1-cur] || ok && !sameEntry(&entries[cur], &entries[1-cur]):
+			first = false
+			running[cur] = ok
+			if ok {
+				fn(&entries[cur])
+			} else {
+				fn(nil)
+			}
+			cur = 1 - cur
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func sameEntry(a, b *TimeEntry) bool {
+	return a.ID == b.ID && a.At.Equal(b.At) && a.Start.Equal(b.Start) && a.Description == b.Description
+}
@@ -0,0 +1,67 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/hitsumabushi/toggl-go/lib"
+	"github.com/hitsumabushi/toggl-go/lib/togglmock"
+)
+
+func newWatchClient(tb testing.TB) (*togglmock.Server, *client.Client) {
+	server := togglmock.NewServer()
+	c, err := server.NewClient(client.WithDefaultWorkspace(1))
+	if err != nil {
+		server.Close()
+		tb.Fatal(err)
+	}
+	return server, c
+}
+
+// CurrentInto allocates less than Current on every poll, as it reuses the entry and the response buffer.
+func TestCurrentIntoAllocs(t *testing.T) {
+	server, c := newWatchClient(t)
+	defer server.Close()
+	ctx := context.Background()
+	var entry client.TimeEntry
+
+	into := testing.AllocsPerRun(50, func() {
+		if _, err := c.TimeEntries.CurrentInto(ctx, &entry); err != nil {
+			t.Fatal(err)
+		}
+	})
+	current := testing.AllocsPerRun(50, func() {
+		if _, err := c.TimeEntries.Current(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Logf("allocations per poll: CurrentInto %.0f, Current %.0f", into, current)
+	if into >= current {
+		t.Errorf("CurrentInto allocates %.0f times per poll, Current %.0f", into, current)
+	}
+}
+
+func BenchmarkCurrentInto(b *testing.B) {
+	server, c := newWatchClient(b)
+	defer server.Close()
+	ctx := context.Background()
+	var entry client.TimeEntry
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.TimeEntries.CurrentInto(ctx, &entry); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCurrent(b *testing.B) {
+	server, c := newWatchClient(b)
+	defer server.Close()
+	ctx := context.Background()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.TimeEntries.Current(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
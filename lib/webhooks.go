@@ -0,0 +1,223 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// WebhookSignatureHeader is the header toggl signs webhook deliveries with
+	WebhookSignatureHeader = "X-Webhook-Signature-256"
+
+	webhookSignaturePrefix = "sha256="
+)
+
+// Webhook event actions
+const (
+	WebhookActionCreated = "created"
+	WebhookActionUpdated = "updated"
+	WebhookActionDeleted = "deleted"
+)
+
+// WebhookEventFilter selects the events a subscription receives.
+// "*" matches every entity or action.
+type WebhookEventFilter struct {
+	Entity string `json:"entity"`
+	Action string `json:"action"`
+}
+
+// WebhookSubscription represent a webhook subscription of a workspace
+type WebhookSubscription struct {
+	ID               int                  `json:"subscription_id,omitempty"`
+	WorkspaceID      int                  `json:"workspace_id,omitempty"`
+	UserID           int                  `json:"user_id,omitempty"`
+	Enabled          bool                 `json:"enabled"`
+	Description      string               `json:"description"`
+	EventFilters     []WebhookEventFilter `json:"event_filters"`
+	URLCallback      string               `json:"url_callback"`
+	Secret           string               `json:"secret,omitempty"`
+	ValidatedAt      *time.Time           `json:"validated_at,omitempty"`
+	HasPendingEvents bool                 `json:"has_pending_events,omitempty"`
+	CreatedAt        *time.Time           `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time           `json:"updated_at,omitempty"`
+}
+
+// WebhooksService handles webhook subscription endpoints
+type WebhooksService struct {
+	client *Client
+}
+
+// List returns webhook subscriptions of the workspace.
+func (s *WebhooksService) List(ctx context.Context, workspaceID int) ([]WebhookSubscription, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	var subscriptions []WebhookSubscription
+	err = s.client.get(ctx, fmt.Sprintf("%s/%d", endpointWebhooks, workspaceID), &subscriptions)
+	return subscriptions, err
+}
+
+// Create registers a new webhook subscription in the workspace.
+// The returned subscription has the secret used to sign deliveries.
+func (s *WebhooksService) Create(ctx context.Context, workspaceID int, subscription *WebhookSubscription) (*WebhookSubscription, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	created := &WebhookSubscription{}
+	err = s.client.do(ctx, "POST", fmt.Sprintf("%s/%d", endpointWebhooks, workspaceID), subscription, created)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Update replaces the subscription with the given one.
+func (s *WebhooksService) Update(ctx context.Context, workspaceID int, subscription *WebhookSubscription) (*WebhookSubscription, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.ID == 0 {
+		return nil, ErrIdUnset
+	}
+	updated := &WebhookSubscription{}
+	err = s.client.do(ctx, "PUT", fmt.Sprintf("%s/%d/%d", endpointWebhooks, workspaceID, subscription.ID), subscription, updated)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Delete removes the subscription.
+func (s *WebhooksService) Delete(ctx context.Context, workspaceID, subscriptionID int) error {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return err
+	}
+	return s.client.do(ctx, "DELETE", fmt.Sprintf("%s/%d/%d", endpointWebhooks, workspaceID, subscriptionID), nil, nil)
+}
+
+// WebhookMetadata describe what happened in a webhook event
+type WebhookMetadata struct {
+	Action      string `json:"action"`
+	Model       string `json:"model"`
+	Path        string `json:"path"`
+	RequestType string `json:"request_type"`
+}
+
+// WebhookEvent is a payload delivered to a webhook subscription
+type WebhookEvent struct {
+	EventID        int64           `json:"event_id"`
+	CreatedAt      time.Time       `json:"created_at"`
+	CreatorID      int             `json:"creator_id"`
+	Metadata       WebhookMetadata `json:"metadata"`
+	Payload        json.RawMessage `json:"payload"`
+	SubscriptionID int             `json:"subscription_id"`
+	Timestamp      time.Time       `json:"timestamp"`
+	URLCallback    string          `json:"url_callback"`
+	ValidationCode string          `json:"validation_code,omitempty"`
+}
+
+// WebhookTimeEntry is a time entry as sent in webhook payloads
+type WebhookTimeEntry struct {
+	ID              int64         `json:"id"`
+	WorkspaceID     int           `json:"workspace_id"`
+	ProjectID       int           `json:"project_id,omitempty"`
+	TaskID          int           `json:"task_id,omitempty"`
+	UserID          int           `json:"user_id"`
+	Billable        bool          `json:"billable"`
+	Start           time.Time     `json:"-"`
+	Stop            time.Time     `json:"-"`
+	Duration        time.Duration `json:"-"`
+	Description     string        `json:"description"`
+	Tags            []string      `json:"tags"`
+	TagIDs          []int         `json:"tag_ids"`
+	At              time.Time     `json:"at"`
+	ServerDeletedAt *time.Time    `json:"server_deleted_at,omitempty"`
+}
+
+// IsRunning reports whether the timer of the entry is running.
+func (e *WebhookTimeEntry) IsRunning() bool {
+	return !e.Start.IsZero() && e.Stop.IsZero() && e.Duration <= 0
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *WebhookTimeEntry) UnmarshalJSON(b []byte) (err error) {
+	type alias WebhookTimeEntry
+	aux := struct {
+		*alias
+		entryTimes
+	}{alias: (*alias)(e)}
+	if err = json.Unmarshal(b, &aux); err != nil {
+		return
+	}
+	e.Start, e.Stop, e.Duration, err = aux.decode()
+	return
+}
+
+// IsPing reports whether the event is the ping sent when a subscription is created or validated.
+func (e *WebhookEvent) IsPing() bool {
+	return string(e.Payload) == `"ping"`
+}
+
+// IsTimeEntry reports whether the event is about a time entry.
+func (e *WebhookEvent) IsTimeEntry() bool {
+	return e.Metadata.Model == "time_entry"
+}
+
+// TimeEntry decodes the payload of a time entry event.
+func (e *WebhookEvent) TimeEntry() (*WebhookTimeEntry, error) {
+	if !e.IsTimeEntry() {
+		return nil, fmt.Errorf("%s event has no time entry payload.\n", e.Metadata.Model)
+	}
+	entry := &WebhookTimeEntry{}
+	if err := json.Unmarshal(e.Payload, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// VerifyWebhookSignature checks the signature header value against the body signed with secret.
+func VerifyWebhookSignature(body []byte, signature, secret string) error {
+	if !strings.HasPrefix(signature, webhookSignaturePrefix) {
+		return ErrInvalidSignature
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, webhookSignaturePrefix))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// ParseWebhook reads an incoming webhook delivery, verifies its signature and decodes the event.
+// It is meant to be called from an http.Handler.
+func ParseWebhook(r *http.Request, secret string) (*WebhookEvent, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	err = VerifyWebhookSignature(body, r.Header.Get(WebhookSignatureHeader), secret)
+	if err != nil {
+		return nil, err
+	}
+	event := &WebhookEvent{}
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
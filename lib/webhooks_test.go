@@ -0,0 +1,85 @@
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func sign(body, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	const body, secret = `{"event_id":1}`, "secret"
+	valid := sign(body, secret)
+	for _, tc := range []struct {
+		name, body, signature string
+		ok                    bool
+	}{
+		{"valid", body, valid, true},
+		{"wrong secret", body, sign(body, "other"), false},
+		{"tampered body", `{"event_id":2}`, valid, false},
+		{"missing prefix", body, strings.TrimPrefix(valid, "sha256="), false},
+		{"other prefix", body, "sha1=" + strings.TrimPrefix(valid, "sha256="), false},
+		{"upper case prefix", body, "SHA256=" + strings.TrimPrefix(valid, "sha256="), false},
+		{"not hex", body, "sha256=" + strings.Repeat("zz", 32), false},
+		{"odd length", body, valid[:len(valid)-1], false},
+		{"truncated", body, valid[:len(valid)-2], false},
+		{"empty", body, "", false},
+	} {
+		err := VerifyWebhookSignature([]byte(tc.body), tc.signature, secret)
+		if tc.ok && err != nil || !tc.ok && err != ErrInvalidSignature {
+			t.Errorf("%s: VerifyWebhookSignature() = %v", tc.name, err)
+		}
+	}
+}
+
+func TestParseWebhook(t *testing.T) {
+	const secret = "secret"
+	request := func(body, signature string) *http.Request {
+		r := httptest.NewRequest("POST", "/webhook", strings.NewReader(body))
+		if signature != "" {
+			r.Header.Set(WebhookSignatureHeader, signature)
+		}
+		return r
+	}
+
+	ping := `{"event_id":1,"payload":"ping","subscription_id":7,"validation_code":"abc123","url_callback":"https://track.toggl.com/webhooks/api/v1/validate/1/7/abc123"}`
+	if _, err := ParseWebhook(request(ping, ""), secret); err != ErrInvalidSignature {
+		t.Errorf("ParseWebhook() of an unsigned request = %v, want ErrInvalidSignature", err)
+	}
+	event, err := ParseWebhook(request(ping, sign(ping, secret)), secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !event.IsPing() || event.ValidationCode != "abc123" || event.SubscriptionID != 7 || event.IsTimeEntry() {
+		t.Errorf("ping event = %+v", event)
+	}
+	if _, err := event.TimeEntry(); err == nil {
+		t.Error("TimeEntry() of a ping returned no error")
+	}
+
+	created := `{"event_id":2,"metadata":{"action":"created","model":"time_entry"},"payload":{"id":5000,"workspace_id":1,"user_id":1000,"description":"Writing fixtures","start":"2016-06-09T01:00:00Z","stop":"2016-06-09T02:00:00Z","duration":3600,"tags":["dev"]}}`
+	event, err = ParseWebhook(request(created, sign(created, secret)), secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, err := event.TimeEntry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.IsPing() || event.Metadata.Action != WebhookActionCreated || entry.ID != 5000 || entry.Duration.Hours() != 1 || entry.IsRunning() {
+		t.Errorf("time entry event = %+v, entry %+v", event, entry)
+	}
+
+	if _, err := ParseWebhook(request("{", sign("{", secret)), secret); err == nil || err == ErrInvalidSignature {
+		t.Errorf("ParseWebhook() of a signed invalid body = %v, want a decoding error", err)
+	}
+}
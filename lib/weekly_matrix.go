@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// WeeklyMatrixRow is a row of a WeeklyMatrix, a project or a user by the grouping of the report
+type WeeklyMatrixRow struct {
+	Title     ReportTitle
+	ProjectID int
+	UserID    int
+	// Days is tracked time per day of the week
+	Days  [7]time.Duration
+	Total time.Duration
+	// Details break the row down by the subgrouping of the report, e.g. the users of a project.
+	// They are not counted in the totals of the matrix again.
+	Details []WeeklyMatrixRow
+}
+
+// Name returns the project, user or client the row is about.
+func (r *WeeklyMatrixRow) Name() string {
+	switch {
+	case r.Title.Project != "":
+		return r.Title.Project
+	case r.Title.User != "":
+		return r.Title.User
+	}
+	return r.Title.Client
+}
+
+// WeeklyMatrix is the weekly report as a table of durations, rows by days, with its totals
+type WeeklyMatrix struct {
+	// Days are the dates of the columns, from the first day of the report
+	Days      [7]time.Time
+	Rows      []WeeklyMatrixRow
+	DayTotals [7]time.Duration
+	Total     time.Duration
+}
+
+// Matrix returns the report as a table starting on since, the Since of its ReportParams.
+// Totals are summed from the days, not taken from the report, so they add up in tables.
+func (r *WeeklyReport) Matrix(since time.Time) *WeeklyMatrix {
+	m := &WeeklyMatrix{Rows: make([]WeeklyMatrixRow, 0, len(r.Data))}
+	day := time.Date(since.Year(), since.Month(), since.Day(), 0, 0, 0, 0, since.Location())
+	for i := range m.Days {
+		m.Days[i] = day.AddDate(0, 0, i)
+	}
+	for i := range r.Data {
+		row := matrixRow(&r.Data[i])
+		for j, d := range row.Days {
+			m.DayTotals[j] += d
+		}
+		m.Total += row.Total
+		m.Rows = append(m.Rows, row)
+	}
+	return m
+}
+
+func matrixRow(data *WeeklyRow) WeeklyMatrixRow {
+	row := WeeklyMatrixRow{Title: data.Title, ProjectID: data.ProjectID, UserID: data.UserID}
+	for i := 0; i < len(row.Days) && i < len(data.Totals); i++ {
+		if data.Totals[i] == nil {
+			continue
+		}
+		d := time.Duration(*data.Totals[i]) * time.Millisecond
+		row.Days[i] = d
+		row.Total += d
+	}
+	for i := range data.Details {
+		row.Details = append(row.Details, matrixRow(&data.Details[i]))
+	}
+	return row
+}
+
+// WeeklyMatrix returns the weekly report as a table, see WeeklyReport.Matrix.
+// The week starts on params.Since, 6 days ago like the API when it is zero.
+func (s *ReportsService) WeeklyMatrix(ctx context.Context, params *ReportParams) (*WeeklyMatrix, error) {
+	report, err := s.Weekly(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	since := params.Since
+	if since.IsZero() {
+		since = time.Now().AddDate(0, 0, -6)
+	}
+	return report.Matrix(since), nil
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestWeeklyMatrix(t *testing.T) {
+	report := &WeeklyReport{}
+	err := json.Unmarshal([]byte(`{"total_grand":99999999,"data":[
+ {"title":{"project":"Website"},"pid":100,"totals":[3600000,1800000,null,null,null,null,null,5400000],"details":[
+  {"title":{"user":"Aiko"},"uid":1,"totals":[3600000,null,null,null,null,null,null,3600000]},
+  {"title":{"user":"Ben"},"uid":2,"totals":[null,1800000,null,null,null,null,null,1800000]}]},
+ {"title":{"project":"Support"},"pid":101,"totals":[null,3600000,null,null,null,null,7200000,10800000]},
+ {"title":{"client":"Acme"},"totals":[]}
+]}`), report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	since := time.Date(2016, 6, 6, 15, 0, 0, 0, time.UTC)
+	m := report.Matrix(since)
+
+	if !m.Days[0].Equal(time.Date(2016, 6, 6, 0, 0, 0, 0, time.UTC)) || !m.Days[6].Equal(time.Date(2016, 6, 12, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("days = %v to %v, want June 6 to 12", m.Days[0], m.Days[6])
+	}
+	if len(m.Rows) != 3 {
+		t.Fatalf("rows = %d, want 3", len(m.Rows))
+	}
+	wantRows := []time.Duration{90 * time.Minute, 3 * time.Hour, 0}
+	for i, want := range wantRows {
+		if m.Rows[i].Total != want {
+			t.Errorf("total of row %s = %v, want %v", m.Rows[i].Name(), m.Rows[i].Total, want)
+		}
+	}
+	wantDays := [7]time.Duration{time.Hour, 90 * time.Minute, 0, 0, 0, 0, 2 * time.Hour}
+	if m.DayTotals != wantDays {
+		t.Errorf("day totals = %v, want %v", m.DayTotals, wantDays)
+	}
+	// Totals add up from the days, whatever total_grand says, and details are not counted twice
+	if m.Total != 270*time.Minute {
+		t.Errorf("total = %v, want 4h30m", m.Total)
+	}
+
+	details := m.Rows[0].Details
+	if len(details) != 2 || details[0].Name() != "Aiko" || details[0].Total != time.Hour || details[1].UserID != 2 || details[1].Days[1] != 30*time.Minute {
+		t.Errorf("details of Website = %+v, want an hour of Aiko and 30 minutes of Ben", details)
+	}
+	if m.Rows[2].Name() != "Acme" || m.Rows[2].Details != nil {
+		t.Errorf("row 3 = %+v, want Acme without details", m.Rows[2])
+	}
+}
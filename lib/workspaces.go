@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Workspace represent a toggl workspace as seen by the owner of the API token
+type Workspace struct {
+	ID                          int       `json:"id"`
+	Name                        string    `json:"name"`
+	Premium                     bool      `json:"premium"`
+	Admin                       bool      `json:"admin"`
+	DefaultHourlyRate           float64   `json:"default_hourly_rate,omitempty"`
+	DefaultCurrency             string    `json:"default_currency,omitempty"`
+	OnlyAdminsMayCreateProjects bool      `json:"only_admins_may_create_projects"`
+	OnlyAdminsSeeBillableRates  bool      `json:"only_admins_see_billable_rates"`
+	OnlyAdminsSeeTeamDashboard  bool      `json:"only_admins_see_team_dashboard"`
+	Rounding                    int       `json:"rounding"`
+	RoundingMinutes             int       `json:"rounding_minutes"`
+	At                          time.Time `json:"at"`
+}
+
+// WorkspacesService handles workspace endpoints
+type WorkspacesService struct {
+	client *Client
+}
+
+// List returns workspaces the owner of the API token belongs to.
+func (s *WorkspacesService) List(ctx context.Context) ([]Workspace, error) {
+	var workspaces []Workspace
+	err := s.client.getCached(ctx, endpointWorkspaces, &workspaces)
+	return workspaces, err
+}
+
+// Get returns the workspace.
+func (s *WorkspacesService) Get(ctx context.Context, workspaceID int) (*Workspace, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	body := struct {
+		Data *Workspace `json:"data"`
+	}{}
+	err = s.client.getCached(ctx, fmt.Sprintf("%s/%d", endpointWorkspaces, workspaceID), &body)
+	if err != nil {
+		return nil, err
+	}
+	return body.Data, nil
+}
+
+// Update changes settings of the workspace with the API v9.
+func (s *WorkspacesService) Update(ctx context.Context, workspaceID int, params *WorkspaceParams) (*Workspace, error) {
+	workspaceID, err := s.client.workspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	workspace := &Workspace{}
+	err = s.client.do(ctx, "PUT", fmt.Sprintf("%s/workspaces/%d", endpointV9, workspaceID), params, workspace)
+	if err != nil {
+		return nil, err
+	}
+	s.client.InvalidateWorkspace(workspaceID)
+	s.client.invalidateCachedWorkspaces()
+	return workspace, nil
+}